@@ -12,17 +12,24 @@ import (
 
 	"github.com/DIMO-Network/model-garage/internal/generator/convert"
 	"github.com/DIMO-Network/model-garage/internal/generator/custom"
+	"github.com/DIMO-Network/model-garage/internal/generator/graphql"
+	"github.com/DIMO-Network/model-garage/internal/generator/jsonschema"
+	"github.com/DIMO-Network/model-garage/internal/generator/proto"
 	"github.com/DIMO-Network/model-garage/pkg/runner"
 	"github.com/DIMO-Network/model-garage/pkg/schema"
 	"github.com/DIMO-Network/model-garage/pkg/version"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == lintCommand {
+		os.Exit(runLint(os.Args[2:]))
+	}
+
 	// Command-line flags
 	printVersion := flag.Bool("version", false, "Print the version of the codegen tool")
 	vspecPath := flag.String("spec", "", "Path to the vspec CSV file if empty, the embedded vspec will be used")
 	definitionPath := flag.String("definitions", "", "Path to the definitions file if empty, the definitions will be used")
-	generators := flag.String("generators", "", "Comma separated list of generators to run. Options: convert, custom.")
+	generators := flag.String("generators", "", "Comma separated list of generators to run. Options: convert, custom, jsonschema, graphql, proto.")
 	// Convert flags
 	copyComments := flag.Bool("convert.copy-comments", false, "Copy through comments on conversion functions. Default is false.")
 	convertPackageName := flag.String("convert.package", "", "Name of the package to generate the conversion functions. If empty, the base model name is used.")
@@ -31,13 +38,34 @@ func main() {
 	customOutFile := flag.String("custom.output-file", custom.DefaultFilePath, "Path of the generate gql file")
 	customTemplateFile := flag.String("custom.template-file", "", "Path to the template file. Which is executed with codegen.TemplateData data.")
 	customFormat := flag.Bool("custom.format", false, "Format the generated file with goimports.")
+	// JSON Schema flags
+	jsonSchemaOutFile := flag.String("jsonschema.output-file", jsonschema.DefaultFilePath, "Path of the generated JSON Schema file.")
+	jsonSchemaLayout := flag.String("jsonschema.layout", jsonschema.LayoutObject, "Layout of the signal values in the payload. Options: object, signals, objects.")
+	jsonSchemaRoot := flag.String("jsonschema.root", jsonschema.DefaultRoot, "Dot separated path of the signal values in the payload.")
+	jsonSchemaPrefix := flag.String("jsonschema.prefix", "", "Only describe original names with this prefix, the prefix is removed from the property names.")
+	jsonSchemaTitle := flag.String("jsonschema.title", "", "Title of the JSON Schema. If empty, the model name is used.")
+	// GraphQL flags
+	graphqlOutFile := flag.String("graphql.output-file", graphql.DefaultFilePath, "Path of the generated GraphQL schema file.")
+
+	// Proto flags
+	protoOutFile := flag.String("proto.output-file", proto.DefaultFilePath, "Path of the generated .proto file. Field numbers in an existing file are kept.")
+	protoGoOutFile := flag.String("proto.go-output-file", "", "Path of the generated Go file with the pb.Schema of the message. If empty, no Go file is generated.")
+	protoPackage := flag.String("proto.package", proto.DefaultProtoPackage, "Protobuf package of the generated messages.")
+	protoGoPackage := flag.String("proto.go-package", proto.DefaultGoPackage, "Package of the generated Go file.")
+	protoMessage := flag.String("proto.message", "", "Prefix of the generated message names. If empty, the model name is used.")
 
 	flag.CommandLine.Usage = func() {
 		_, _ = fmt.Fprintf(flag.CommandLine.Output(), `
 codegen is a tool to generate code for the model-garage project.
 Available generators:
 	- custom: Runs a given golang template with pkg/schema.TemplateData data.
-	- convert: Generates conversion functions for converting between raw data into signals.`)
+	- convert: Generates conversion functions for converting between raw data into signals.
+	- jsonschema: Generates a JSON Schema (draft 2020-12) describing the raw payload read by the conversion functions.
+	- graphql: Generates a GraphQL schema with SignalCollection and SignalAggregations types for the signals.
+	- proto: Generates a .proto file with a snapshot message for the signals and the matching pb.Schema.
+Subcommands:
+	- lint: Lints a definitions file, run 'codegen lint -h' for details.
+`)
 		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage:\n")
 		flag.PrintDefaults()
 	}
@@ -86,6 +114,23 @@ Available generators:
 			PackageName:  *convertPackageName,
 			OutputFile:   *convertOutputFile,
 		},
+		JSONSchema: jsonschema.Config{
+			OutputFile: *jsonSchemaOutFile,
+			Layout:     *jsonSchemaLayout,
+			Root:       *jsonSchemaRoot,
+			Prefix:     *jsonSchemaPrefix,
+			Title:      *jsonSchemaTitle,
+		},
+		GraphQL: graphql.Config{
+			OutputFile: *graphqlOutFile,
+		},
+		Proto: proto.Config{
+			OutputFile:   *protoOutFile,
+			GoOutputFile: *protoGoOutFile,
+			GoPackage:    *protoGoPackage,
+			ProtoPackage: *protoPackage,
+			MessageName:  *protoMessage,
+		},
 	}
 
 	err := runner.Execute(vspecReader, definitionReader, gens, cfg)
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+)
+
+// lintCommand is the name of the subcommand that lints a definitions file.
+const lintCommand = "lint"
+
+// runLint lints a definitions file and writes the issues as JSON to stdout.
+// It returns the exit code, which is non-zero if any issue is an error.
+func runLint(args []string) int {
+	flags := flag.NewFlagSet(lintCommand, flag.ExitOnError)
+	vspecPath := flags.String("spec", "", "Path to the vspec CSV file if empty, the embedded vspec will be used")
+	definitionPath := flags.String("definitions", "", "Path to the definitions file if empty, the default definitions will be used")
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "Usage: codegen lint [flags]\nLints a definitions file and prints the issues as JSON.\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	vspecReader := io.Reader(strings.NewReader(schema.VssRel42DIMO()))
+	if *vspecPath != "" {
+		f, err := os.Open(filepath.Clean(*vspecPath))
+		if err != nil {
+			log.Printf("failed to open file: %v", err)
+			return 1
+		}
+		//nolint:errcheck // we don't care about the error since we are not writing to the file
+		defer f.Close()
+		vspecReader = f
+	}
+	definitionReader := io.Reader(strings.NewReader(schema.DefaultDefinitionsYAML()))
+	if *definitionPath != "" {
+		f, err := os.Open(filepath.Clean(*definitionPath))
+		if err != nil {
+			log.Printf("failed to open file: %v", err)
+			return 1
+		}
+		//nolint:errcheck // we don't care about the error since we are not writing to the file
+		defer f.Close()
+		definitionReader = f
+	}
+
+	issues, err := schema.LintDefinitions(vspecReader, definitionReader)
+	if err != nil {
+		log.Printf("failed to lint definitions: %v", err)
+		return 1
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(issues); err != nil {
+		log.Printf("failed to write issues: %v", err)
+		return 1
+	}
+	if schema.HasLintErrors(issues) {
+		return 1
+	}
+	return 0
+}
@@ -49,6 +49,28 @@ type funcTmplData struct {
 	DocComment string
 	// Body of the original conversion function if it exists.
 	Body string
+	// Transform is the declarative transform of the conversion if it declares a unit, multiplier, offset, error values or byte size.
+	Transform *transformTmplData
+}
+
+// transformTmplData contains the data to be used during template execution for writing a declarative conversion body.
+type transformTmplData struct {
+	// ByteSize is the size of the hex encoded original value, or zero if the original value is a float64.
+	ByteSize int
+	// Signed is true if the ByteSize original value is a two's complement signed integer.
+	Signed bool
+	// RawMin and RawMax are the bounds of valid raw values, or empty if the raw value is not bounded.
+	RawMin string
+	RawMax string
+	// ErrorValues are the raw values that mean the value is not available.
+	ErrorValues []string
+	// Expr is the expression that computes the converted value.
+	Expr string
+	// SourceFunc is the hand-written function that returns the value in the unit of the conversion.
+	// It is set for conversions that only declare a unit on a value that is not a float64, such as a Ruptela OID.
+	SourceFunc string
+	// SourceBody is the body of SourceFunc if it exists.
+	SourceBody string
 }
 
 // Generate creates a conversion functions for each field of a model struct.
@@ -63,7 +85,10 @@ func Generate(tmplData *schema.TemplateData, cfg Config) (err error) {
 	}
 
 	// Get the conversion functions that need to be generated.
-	convertFunc := getConversionFunctions(tmplData.Signals)
+	convertFunc, err := getConversionFunctions(tmplData.Signals)
+	if err != nil {
+		return err
+	}
 	if len(convertFunc) == 0 {
 		return nil
 	}
@@ -28,7 +28,7 @@ type FunctionInfo struct {
 }
 
 // getConversionFunctions returns the signals that need conversion functions.
-func getConversionFunctions(signals []*schema.SignalInfo) []funcTmplData {
+func getConversionFunctions(signals []*schema.SignalInfo) ([]funcTmplData, error) {
 	var convertFunc []funcTmplData
 	for _, signal := range signals {
 		for i := range signal.Conversions {
@@ -38,16 +38,80 @@ func getConversionFunctions(signals []*schema.SignalInfo) []funcTmplData {
 				Conversion: signal.Conversions[i],
 				FuncName:   funcName,
 			}
+			transform, err := getTransform(signal, convData.Conversion)
+			if err != nil {
+				return nil, fmt.Errorf("error creating declarative conversion for function %s: %w", funcName, err)
+			}
+			convData.Transform = transform
 
 			convertFunc = append(convertFunc, convData)
 		}
 	}
-	return convertFunc
+	return convertFunc, nil
+}
+
+// getTransform returns the declarative transform of a conversion, or nil if the conversion does not declare one.
+func getTransform(signal *schema.SignalInfo, conv *schema.ConversionInfo) (*transformTmplData, error) {
+	if conv.Unit == "" && !conv.HasLinearTransform() {
+		return nil, nil
+	}
+	if signal.GOType() != "float64" {
+		return nil, fmt.Errorf("declarative conversions require a float64 signal, got '%s'", signal.GOType())
+	}
+	transform := &transformTmplData{}
+	expr := "val"
+	if !conv.HasLinearTransform() && conv.OriginalType != "float64" {
+		// The value is read by a hand-written function and only its unit is converted.
+		transform.SourceFunc = sourceFuncName(signal, conv)
+		expr = "ret"
+	} else if conv.ByteSize != 0 {
+		if conv.OriginalType != "string" {
+			return nil, fmt.Errorf("byteSize requires a hex string original value, got '%s'", conv.OriginalType)
+		}
+		transform.ByteSize = conv.ByteSize
+		transform.Signed = conv.Signed
+		if conv.RawMin != nil {
+			transform.RawMin = strconv.FormatInt(*conv.RawMin, 10)
+		}
+		if conv.RawMax != nil {
+			transform.RawMax = strconv.FormatInt(*conv.RawMax, 10)
+		}
+		expr = "float64(rawInt)"
+	} else if conv.OriginalType != "float64" {
+		return nil, fmt.Errorf("declarative conversions require a float64 original value, got '%s'", conv.OriginalType)
+	}
+	for _, errVal := range conv.ErrorValues {
+		transform.ErrorValues = append(transform.ErrorValues, strconv.FormatInt(errVal, 10))
+	}
+
+	multiplier := conv.Multiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+	expr = schema.LinearExpr(expr, multiplier, conv.Offset)
+	if conv.Unit != "" {
+		if signal.Unit == "" {
+			return nil, fmt.Errorf("signal '%s' does not have a unit", signal.Name)
+		}
+		var err error
+		expr, err = schema.UnitConversionExpr(expr, conv.Unit, signal.Unit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	transform.Expr = expr
+	return transform, nil
+}
+
+// sourceFuncName returns the name of the hand-written function that reads a conversion in its source unit.
+func sourceFuncName(signal *schema.SignalInfo, conv *schema.ConversionInfo) string {
+	idx := slices.Index(signal.Conversions, conv)
+	return "to" + signal.GOName + strconv.Itoa(idx)
 }
 
 // createConvertFuncTemplate creates a template for generating conversion functions.
 func createConvertFuncTemplate() (*template.Template, error) {
-	tmpl, err := template.New("convertFuncTemplate").Parse(convertFuncTemplateStr)
+	tmpl, err := template.New("convertFuncTemplate").Funcs(template.FuncMap{"join": strings.Join}).Parse(convertFuncTemplateStr)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing go struct template: %w", err)
 	}
@@ -157,12 +221,21 @@ func writeConvertFuncs(convertFunc []funcTmplData, existingFuncs map[string]Func
 	for _, convData := range convertFunc {
 		funcName := convData.FuncName
 		if fnInfo, exists := existingFuncs[funcName]; exists {
-			convData.Body = string(fnInfo.Body)
+			// bodies of declarative conversions are always regenerated.
+			if convData.Transform == nil {
+				convData.Body = string(fnInfo.Body)
+			}
 			if copyComments {
 				convData.DocComment = fnInfo.Comments
 			}
 		}
 
+		if convData.Transform != nil && convData.Transform.SourceFunc != "" {
+			if fnInfo, exists := existingFuncs[convData.Transform.SourceFunc]; exists {
+				convData.Transform.SourceBody = string(fnInfo.Body)
+			}
+		}
+
 		err := tmpl.Execute(&convertBuff, convData)
 		if err != nil {
 			return fmt.Errorf("error executing template for function %s: %w", funcName, err)
@@ -0,0 +1,133 @@
+// Package graphql provides a generator that writes the GraphQL schema of the signals.
+package graphql
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+)
+
+// DefaultFilePath is the default path of the generated GraphQL schema.
+const DefaultFilePath = "signals.graphqls"
+
+//go:embed graphql.tmpl
+var graphqlTemplateStr string
+
+// Config is the configuration for the GraphQL generator.
+type Config struct {
+	// OutputFile is the path of the generated GraphQL schema.
+	OutputFile string
+}
+
+// tmplData contains the data to be used during template execution.
+type tmplData struct {
+	Privileges []string
+	ValueTypes []valueType
+	Signals    []signalTmplData
+}
+
+// valueType is a GraphQL type holding a timestamped value.
+type valueType struct {
+	Name  string
+	Value string
+}
+
+// signalTmplData contains the data to be used during template execution for a single signal field.
+type signalTmplData struct {
+	Name        string
+	Description string
+	GQLType     string
+	ValueType   string
+	Aggregation string
+	Directive   string
+}
+
+// Generate writes the GraphQL schema of the signals.
+func Generate(tmplData *schema.TemplateData, cfg Config) error {
+	cfg.OutputFile = filepath.Clean(cfg.OutputFile)
+	if cfg.OutputFile == "" || cfg.OutputFile == "." {
+		cfg.OutputFile = DefaultFilePath
+	}
+	tmpl, err := template.New("graphqlTemplate").Parse(graphqlTemplateStr)
+	if err != nil {
+		return fmt.Errorf("error parsing graphql template: %w", err)
+	}
+	var outBuf bytes.Buffer
+	if err := tmpl.Execute(&outBuf, getTemplateData(tmplData.Signals)); err != nil {
+		return fmt.Errorf("error executing graphql template: %w", err)
+	}
+	if err := os.WriteFile(cfg.OutputFile, outBuf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("error writing graphql file: %w", err)
+	}
+	return nil
+}
+
+// getTemplateData converts the signals into GraphQL fields.
+func getTemplateData(signals []*schema.SignalInfo) tmplData {
+	data := tmplData{Privileges: schema.Privileges()}
+	for _, sig := range signals {
+		if sig.GQLType() == "" {
+			continue
+		}
+		gqlSig := signalTmplData{
+			Name:        sig.JSONName,
+			Description: description(sig),
+			GQLType:     sig.GQLType(),
+			ValueType:   "Signal" + sig.GQLType(),
+		}
+		value := sig.GQLType()
+		if sig.IsArray {
+			gqlSig.ValueType += "Array"
+			value = "[" + value + "!]"
+		} else {
+			gqlSig.Aggregation = sig.GQLType() + "Aggregation"
+		}
+		if !slices.ContainsFunc(data.ValueTypes, func(v valueType) bool { return v.Name == gqlSig.ValueType }) {
+			data.ValueTypes = append(data.ValueTypes, valueType{Name: gqlSig.ValueType, Value: value})
+		}
+		if len(sig.Privileges) != 0 {
+			gqlSig.Directive = fmt.Sprintf(" @requiresPrivilege(privileges: [%s])", strings.Join(sig.Privileges, ", "))
+		}
+		data.Signals = append(data.Signals, gqlSig)
+	}
+	slices.SortFunc(data.ValueTypes, func(a, b valueType) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return data
+}
+
+// description returns the GraphQL description of a signal, indented for a field.
+func description(sig *schema.SignalInfo) string {
+	lines := []string{sig.Desc}
+	if sig.Comment != "" {
+		lines = append(lines, sig.Comment)
+	}
+	var details []string
+	if sig.Unit != "" {
+		details = append(details, fmt.Sprintf("Unit: '%s'", sig.Unit))
+	}
+	if sig.Min != "" {
+		details = append(details, fmt.Sprintf("Min: '%s'", sig.Min))
+	}
+	if sig.Max != "" {
+		details = append(details, fmt.Sprintf("Max: '%s'", sig.Max))
+	}
+	if len(details) != 0 {
+		lines = append(lines, strings.Join(details, " "))
+	}
+	if len(sig.Privileges) != 0 {
+		lines = append(lines, fmt.Sprintf("Required Privileges: %v", sig.Privileges))
+	}
+	lines = strings.Split(strings.Join(lines, "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = "  " + strings.ReplaceAll(line, `"""`, `\"""`)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,41 @@
+package graphql_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/internal/generator/graphql"
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const testDefinitions = `
+- vspecName: Vehicle.Speed
+  requiredPrivileges:
+    - VEHICLE_NON_LOCATION_DATA
+- vspecName: Vehicle.Powertrain.Type
+- vspecName: Vehicle.Powertrain.FuelSystem.SupportedFuelTypes
+`
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	tmplData, err := schema.GetDefinedSignals(strings.NewReader(schema.VssRel42DIMO()), strings.NewReader(testDefinitions))
+	require.NoError(t, err)
+
+	outputFile := filepath.Join(t.TempDir(), "signals.graphqls")
+	require.NoError(t, graphql.Generate(tmplData, graphql.Config{OutputFile: outputFile}))
+	data, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+	gqlSchema := string(data)
+
+	require.Contains(t, gqlSchema, "  speed: SignalFloat @requiresPrivilege(privileges: [VEHICLE_NON_LOCATION_DATA])\n")
+	require.Contains(t, gqlSchema, "  speed(agg: FloatAggregation!): Float @requiresPrivilege(privileges: [VEHICLE_NON_LOCATION_DATA])\n")
+	require.Contains(t, gqlSchema, "  Vehicle speed.\n  Unit: 'km/h'\n")
+	require.Contains(t, gqlSchema, "  powertrainType: SignalString\n")
+	require.Contains(t, gqlSchema, "  powertrainType(agg: StringAggregation!): String\n")
+	require.Contains(t, gqlSchema, "  powertrainFuelSystemSupportedFuelTypes: SignalStringArray\n")
+	require.NotContains(t, gqlSchema, "powertrainFuelSystemSupportedFuelTypes(agg")
+	require.Contains(t, gqlSchema, "  value: [String!]!\n")
+}
@@ -0,0 +1,237 @@
+// Package jsonschema provides a generator that writes a JSON Schema describing the raw payload a converter reads.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+)
+
+const (
+	// DefaultFilePath is the default path of the generated JSON Schema.
+	DefaultFilePath = "schema.json"
+	// DefaultRoot is the default path of the signal values in the payload.
+	DefaultRoot = "data"
+
+	// LayoutObject describes payloads where each originalName is a path into the object at Root, such as v1 status payloads.
+	LayoutObject = "object"
+	// LayoutSignals describes payloads where Root is an array of {name, timestamp, value} objects, such as v2 status payloads.
+	LayoutSignals = "signals"
+	// LayoutObjects describes payloads where Root is an array of objects that each hold the original names, such as ruptela location payloads.
+	LayoutObjects = "objects"
+
+	draft2020 = "https://json-schema.org/draft/2020-12/schema"
+)
+
+var layouts = []string{LayoutObject, LayoutSignals, LayoutObjects}
+
+// Config is the configuration for the JSON Schema generator.
+type Config struct {
+	// OutputFile is the path of the generated JSON Schema.
+	OutputFile string
+	// Layout is how signal values are laid out in the payload, one of object, signals or objects.
+	// Defaults to object.
+	Layout string
+	// Root is the dot separated path of the signal values in the payload.
+	// Defaults to data.
+	Root string
+	// Prefix limits the schema to original names with this prefix, the prefix is removed from the property names.
+	Prefix string
+	// Title is the title of the schema.
+	Title string
+}
+
+// Schema is a JSON Schema.
+type Schema struct {
+	Schema      string             `json:"$schema,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        any                `json:"type,omitempty"`
+	Const       string             `json:"const,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	Required    []string           `json:"required,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	AllOf       []*Schema          `json:"allOf,omitempty"`
+	If          *Schema            `json:"if,omitempty"`
+	Then        *Schema            `json:"then,omitempty"`
+}
+
+// Generate writes a JSON Schema for the payload read by the converters of the given signals.
+func Generate(tmplData *schema.TemplateData, cfg Config) error {
+	cfg.OutputFile = filepath.Clean(cfg.OutputFile)
+	if cfg.OutputFile == "" || cfg.OutputFile == "." {
+		cfg.OutputFile = DefaultFilePath
+	}
+	if cfg.Title == "" {
+		cfg.Title = tmplData.ModelName
+	}
+
+	jsonSchema, err := Build(tmplData, cfg)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(jsonSchema, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON Schema: %w", err)
+	}
+	data = append(data, '\n')
+	if err := os.WriteFile(cfg.OutputFile, data, 0o600); err != nil {
+		return fmt.Errorf("error writing JSON Schema file: %w", err)
+	}
+	return nil
+}
+
+// Build creates the JSON Schema for the payload read by the converters of the given signals.
+func Build(tmplData *schema.TemplateData, cfg Config) (*Schema, error) {
+	if cfg.Layout == "" {
+		cfg.Layout = LayoutObject
+	}
+	if cfg.Root == "" {
+		cfg.Root = DefaultRoot
+	}
+	if !slices.Contains(layouts, cfg.Layout) {
+		return nil, fmt.Errorf("unknown layout '%s' must be one of %v", cfg.Layout, layouts)
+	}
+
+	fields := getFields(tmplData.OriginalNames, cfg.Prefix)
+	var values *Schema
+	switch cfg.Layout {
+	case LayoutObject:
+		values = objectSchema(fields)
+	case LayoutObjects:
+		values = &Schema{Type: "array", Items: objectSchema(fields)}
+	case LayoutSignals:
+		values = signalsSchema(fields)
+	}
+
+	root := values
+	parts := strings.Split(cfg.Root, ".")
+	for i := len(parts) - 1; i >= 0; i-- {
+		root = &Schema{
+			Type:       "object",
+			Properties: map[string]*Schema{parts[i]: root},
+			Required:   []string{parts[i]},
+		}
+	}
+	root.Schema = draft2020
+	root.Title = cfg.Title
+	return root, nil
+}
+
+// field is a single value read from the payload.
+type field struct {
+	name   string
+	schema *Schema
+}
+
+// getFields returns the schema of each original name with the given prefix.
+func getFields(originalNames []*schema.OriginalNameInfo, prefix string) []field {
+	var fields []field
+	for _, origInfo := range originalNames {
+		name, ok := strings.CutPrefix(origInfo.Name, prefix)
+		if !ok || name == "" {
+			continue
+		}
+		var conv *schema.ConversionInfo
+		var vspecNames []string
+		for _, sig := range origInfo.Signals {
+			vspecNames = append(vspecNames, sig.Name)
+			for _, sigConv := range sig.Conversions {
+				if conv == nil && sigConv.OriginalName == origInfo.Name {
+					conv = sigConv
+				}
+			}
+		}
+		if conv == nil {
+			continue
+		}
+		valueSchema := typeSchema(conv)
+		valueSchema.Description = "Converted to " + strings.Join(vspecNames, ", ")
+		fields = append(fields, field{name: name, schema: valueSchema})
+	}
+	return fields
+}
+
+// typeSchema returns the schema of an original value.
+// null is always allowed since converters skip null values.
+func typeSchema(conv *schema.ConversionInfo) *Schema {
+	var jsonType string
+	switch {
+	case conv.OriginalType == "string":
+		jsonType = "string"
+	case conv.OriginalType == "bool":
+		jsonType = "boolean"
+	case strings.HasPrefix(conv.OriginalType, "float"):
+		jsonType = "number"
+	case strings.HasPrefix(conv.OriginalType, "int"), strings.HasPrefix(conv.OriginalType, "uint"):
+		jsonType = "integer"
+	}
+	if conv.IsArray {
+		items := &Schema{}
+		if jsonType != "" {
+			items.Type = jsonType
+		}
+		return &Schema{Type: []string{"array", "null"}, Items: items}
+	}
+	if jsonType == "" {
+		return &Schema{}
+	}
+	return &Schema{Type: []string{jsonType, "null"}}
+}
+
+// objectSchema returns the schema of an object holding the fields at their dot separated paths.
+func objectSchema(fields []field) *Schema {
+	obj := &Schema{Type: "object"}
+	for _, f := range fields {
+		parent := obj
+		parts := strings.Split(f.name, ".")
+		for _, part := range parts[:len(parts)-1] {
+			if parent.Properties == nil {
+				parent.Properties = map[string]*Schema{}
+			}
+			child, ok := parent.Properties[part]
+			if !ok || child.Type != "object" {
+				child = &Schema{Type: "object"}
+				parent.Properties[part] = child
+			}
+			parent = child
+		}
+		if parent.Properties == nil {
+			parent.Properties = map[string]*Schema{}
+		}
+		parent.Properties[parts[len(parts)-1]] = f.schema
+	}
+	return obj
+}
+
+// signalsSchema returns the schema of an array of {name, timestamp, value} objects.
+// Signals with unknown names are ignored by converters so any name is allowed.
+func signalsSchema(fields []field) *Schema {
+	valueChecks := make([]*Schema, 0, len(fields))
+	for _, f := range fields {
+		valueChecks = append(valueChecks, &Schema{
+			If: &Schema{Properties: map[string]*Schema{"name": {Const: f.name}}},
+			Then: &Schema{
+				Properties: map[string]*Schema{"value": f.schema},
+			},
+		})
+	}
+	return &Schema{
+		Type: []string{"array", "null"},
+		Items: &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"name":      {Type: "string"},
+				"timestamp": {},
+				"value":     {},
+			},
+			Required: []string{"name", "timestamp", "value"},
+			AllOf:    valueChecks,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package jsonschema_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/internal/generator/jsonschema"
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+	"github.com/stretchr/testify/require"
+)
+
+const testDefinitions = `
+- vspecName: Vehicle.Speed
+  conversions:
+    - originalName: pos.spd
+      originalType: float64
+- vspecName: Vehicle.Powertrain.Type
+  conversions:
+    - originalName: signals.99
+      originalType: string
+`
+
+func getTestData(t *testing.T) *schema.TemplateData {
+	t.Helper()
+	tmplData, err := schema.GetDefinedSignals(strings.NewReader(schema.VssRel42DIMO()), strings.NewReader(testDefinitions))
+	require.NoError(t, err)
+	return tmplData
+}
+
+func TestBuildObject(t *testing.T) {
+	t.Parallel()
+	jsonSchema, err := jsonschema.Build(getTestData(t), jsonschema.Config{Title: "test"})
+	require.NoError(t, err)
+	require.Equal(t, "https://json-schema.org/draft/2020-12/schema", jsonSchema.Schema)
+	require.Equal(t, "test", jsonSchema.Title)
+	require.Equal(t, []string{"data"}, jsonSchema.Required)
+
+	data := jsonSchema.Properties["data"]
+	speed := data.Properties["pos"].Properties["spd"]
+	require.Equal(t, []string{"number", "null"}, speed.Type)
+	require.Equal(t, "Converted to Vehicle.Speed", speed.Description)
+	powertrainType := data.Properties["signals"].Properties["99"]
+	require.Equal(t, []string{"string", "null"}, powertrainType.Type)
+}
+
+func TestBuildSignals(t *testing.T) {
+	t.Parallel()
+	jsonSchema, err := jsonschema.Build(getTestData(t), jsonschema.Config{Layout: jsonschema.LayoutSignals, Root: "data.vehicle.signals"})
+	require.NoError(t, err)
+
+	signals := jsonSchema.Properties["data"].Properties["vehicle"].Properties["signals"]
+	require.Equal(t, []string{"name", "timestamp", "value"}, signals.Items.Required)
+	require.Len(t, signals.Items.AllOf, 2)
+	check := signals.Items.AllOf[0]
+	require.Equal(t, "pos.spd", check.If.Properties["name"].Const)
+	require.Equal(t, []string{"number", "null"}, check.Then.Properties["value"].Type)
+}
+
+func TestBuildObjectsWithPrefix(t *testing.T) {
+	t.Parallel()
+	jsonSchema, err := jsonschema.Build(getTestData(t), jsonschema.Config{Layout: jsonschema.LayoutObjects, Root: "data.location", Prefix: "pos."})
+	require.NoError(t, err)
+
+	location := jsonSchema.Properties["data"].Properties["location"]
+	require.Equal(t, "array", location.Type)
+	require.Len(t, location.Items.Properties, 1)
+	require.Contains(t, location.Items.Properties, "spd")
+}
+
+func TestBuildUnknownLayout(t *testing.T) {
+	t.Parallel()
+	_, err := jsonschema.Build(getTestData(t), jsonschema.Config{Layout: "table"})
+	require.Error(t, err)
+}
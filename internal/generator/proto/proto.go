@@ -0,0 +1,297 @@
+// Package proto provides a generator that writes a protobuf schema for the signals and the matching pb.Schema.
+package proto
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/DIMO-Network/model-garage/pkg/codegen"
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+	"github.com/DIMO-Network/model-garage/pkg/vss/pb"
+)
+
+const (
+	// DefaultFilePath is the default path of the generated .proto file.
+	DefaultFilePath = "signals.proto"
+	// DefaultProtoPackage is the default protobuf package of the generated messages.
+	DefaultProtoPackage = "dimo.vss"
+	// DefaultGoPackage is the default package of the generated Go schema file.
+	DefaultGoPackage = "pb"
+)
+
+//go:embed proto.tmpl
+var protoTemplateStr string
+
+//go:embed schema.tmpl
+var schemaTemplateStr string
+
+// Config is the configuration for the protobuf generator.
+type Config struct {
+	// OutputFile is the path of the generated .proto file.
+	// Field numbers of an existing file at this path are kept.
+	OutputFile string
+	// GoOutputFile is the path of the generated Go file with the pb.Schema of the message.
+	// If empty, no Go file is generated.
+	GoOutputFile string
+	// GoPackage is the package of the generated Go file.
+	GoPackage string
+	// ProtoPackage is the protobuf package of the generated messages.
+	ProtoPackage string
+	// MessageName is the prefix of the generated message names.
+	// If empty, the model name is used.
+	MessageName string
+}
+
+// tmplData contains the data to be used during template execution.
+type tmplData struct {
+	ProtoPackage string
+	ProtoFile    string
+	GoPackage    string
+	Qualifier    string
+	MessageName  string
+	Reserved     string
+	Fields       []fieldTmplData
+}
+
+// fieldTmplData contains the data to be used during template execution for a single signal field.
+type fieldTmplData struct {
+	SignalName string
+	Name       string
+	Desc       string
+	Label      string
+	ProtoType  string
+	DataType   string
+	Number     int
+}
+
+// protoTypes maps VSS data types to protobuf types.
+var protoTypes = map[string]string{
+	"boolean": "bool",
+	"string":  "string",
+	"float":   "double",
+	"double":  "double",
+	"int8":    "sint32",
+	"int16":   "sint32",
+	"int32":   "sint32",
+	"int64":   "sint64",
+	"uint8":   "uint32",
+	"uint16":  "uint32",
+	"uint32":  "uint32",
+	"uint64":  "uint64",
+}
+
+// Generate writes the .proto file and the Go schema file for the signals.
+func Generate(tmplData *schema.TemplateData, cfg Config) error {
+	cfg.OutputFile = filepath.Clean(cfg.OutputFile)
+	if cfg.OutputFile == "" || cfg.OutputFile == "." {
+		cfg.OutputFile = DefaultFilePath
+	}
+	if cfg.GoPackage == "" {
+		cfg.GoPackage = DefaultGoPackage
+	}
+	if cfg.ProtoPackage == "" {
+		cfg.ProtoPackage = DefaultProtoPackage
+	}
+	if cfg.MessageName == "" {
+		cfg.MessageName = tmplData.ModelName
+	}
+
+	existing, err := readFieldNumbers(cfg.OutputFile)
+	if err != nil {
+		return err
+	}
+	data, err := getTemplateData(tmplData.Signals, existing, cfg)
+	if err != nil {
+		return err
+	}
+
+	protoTmpl, err := template.New("protoTemplate").Parse(protoTemplateStr)
+	if err != nil {
+		return fmt.Errorf("error parsing proto template: %w", err)
+	}
+	var protoBuf bytes.Buffer
+	if err := protoTmpl.Execute(&protoBuf, data); err != nil {
+		return fmt.Errorf("error executing proto template: %w", err)
+	}
+	if err := os.WriteFile(cfg.OutputFile, protoBuf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("error writing proto file: %w", err)
+	}
+
+	if cfg.GoOutputFile == "" {
+		return nil
+	}
+	schemaTmpl, err := template.New("schemaTemplate").Parse(schemaTemplateStr)
+	if err != nil {
+		return fmt.Errorf("error parsing proto schema template: %w", err)
+	}
+	var goBuf bytes.Buffer
+	if err := schemaTmpl.Execute(&goBuf, data); err != nil {
+		return fmt.Errorf("error executing proto schema template: %w", err)
+	}
+	if err := codegen.FormatAndWriteToFile(goBuf.Bytes(), filepath.Clean(cfg.GoOutputFile)); err != nil {
+		return fmt.Errorf("error writing proto schema file: %w", err)
+	}
+	return nil
+}
+
+// fieldNumbers are the field numbers of a previously generated .proto file.
+type fieldNumbers struct {
+	byName   map[string]int
+	reserved []int
+}
+
+var (
+	fieldLineRegex    = regexp.MustCompile(`^\s*(?:optional\s+|repeated\s+)?\w+\s+(\w+)\s*=\s*(\d+)\s*;`)
+	reservedLineRegex = regexp.MustCompile(`^\s*reserved\s+([\d,\s]+);`)
+	messageLineRegex  = regexp.MustCompile(`^\s*message\s+(\w+)`)
+)
+
+// readFieldNumbers reads the signal field numbers of the snapshot message of an existing .proto file.
+func readFieldNumbers(protoFile string) (*fieldNumbers, error) {
+	numbers := &fieldNumbers{byName: map[string]int{}}
+	data, err := os.ReadFile(protoFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return numbers, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading existing proto file: %w", err)
+	}
+	inSnapshot := false
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := messageLineRegex.FindStringSubmatch(line); match != nil {
+			inSnapshot = strings.HasSuffix(match[1], "Snapshot")
+			continue
+		}
+		if !inSnapshot {
+			continue
+		}
+		if match := reservedLineRegex.FindStringSubmatch(line); match != nil {
+			for _, num := range strings.Split(match[1], ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(num))
+				if err != nil {
+					return nil, fmt.Errorf("error parsing reserved field number '%s': %w", num, err)
+				}
+				numbers.reserved = append(numbers.reserved, n)
+			}
+			continue
+		}
+		if match := fieldLineRegex.FindStringSubmatch(line); match != nil {
+			n, err := strconv.Atoi(match[2])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing field number of '%s': %w", match[1], err)
+			}
+			if n >= int(pb.FirstSignalField) {
+				numbers.byName[match[1]] = n
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading existing proto file: %w", err)
+	}
+	return numbers, nil
+}
+
+// getTemplateData assigns field numbers to the signals.
+// Existing fields keep their numbers, new fields get numbers that were never used, and removed fields are reserved.
+func getTemplateData(signals []*schema.SignalInfo, existing *fieldNumbers, cfg Config) (*tmplData, error) {
+	data := &tmplData{
+		ProtoPackage: cfg.ProtoPackage,
+		ProtoFile:    filepath.Base(cfg.OutputFile),
+		GoPackage:    cfg.GoPackage,
+		MessageName:  cfg.MessageName,
+	}
+	if cfg.GoPackage != DefaultGoPackage {
+		data.Qualifier = "pb."
+	}
+
+	nextNumber := int(pb.FirstSignalField)
+	for _, n := range existing.byName {
+		nextNumber = max(nextNumber, n+1)
+	}
+	for _, n := range existing.reserved {
+		nextNumber = max(nextNumber, n+1)
+	}
+
+	used := map[string]bool{}
+	for _, sig := range signals {
+		baseType, _ := strings.CutSuffix(sig.DataType, "[]")
+		protoType, ok := protoTypes[baseType]
+		if !ok {
+			return nil, fmt.Errorf("signal '%s' has unsupported data type '%s'", sig.Name, sig.DataType)
+		}
+		if sig.IsArray && baseType != "string" {
+			return nil, fmt.Errorf("signal '%s' has unsupported array data type '%s'", sig.Name, sig.DataType)
+		}
+		field := fieldTmplData{
+			SignalName: sig.JSONName,
+			Name:       toSnakeCase(sig.JSONName),
+			Desc:       sig.Desc,
+			Label:      "optional ",
+			ProtoType:  protoType,
+			DataType:   sig.DataType,
+		}
+		if sig.IsArray {
+			field.Label = "repeated "
+		}
+		if n, ok := existing.byName[field.Name]; ok {
+			field.Number = n
+		} else {
+			field.Number = nextNumber
+			nextNumber++
+		}
+		used[field.Name] = true
+		data.Fields = append(data.Fields, field)
+	}
+	slices.SortFunc(data.Fields, func(a, b fieldTmplData) int {
+		return a.Number - b.Number
+	})
+
+	reserved := slices.Clone(existing.reserved)
+	for name, n := range existing.byName {
+		if !used[name] {
+			reserved = append(reserved, n)
+		}
+	}
+	slices.Sort(reserved)
+	reserved = slices.Compact(reserved)
+	reservedStrs := make([]string, len(reserved))
+	for i, n := range reserved {
+		reservedStrs[i] = strconv.Itoa(n)
+	}
+	data.Reserved = strings.Join(reservedStrs, ", ")
+	return data, nil
+}
+
+// toSnakeCase converts a lower camel case JSON name to a snake case protobuf field name.
+// Runs of upper case letters are kept together, for example dimoAftermarketHDOP becomes dimo_aftermarket_hdop.
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var builder strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]))
+			nextLower := i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1])
+			if prevLower || nextLower {
+				_ = builder.WriteByte('_')
+			}
+			_, _ = builder.WriteRune(unicode.ToLower(r))
+			continue
+		}
+		_, _ = builder.WriteRune(r)
+	}
+	return builder.String()
+}
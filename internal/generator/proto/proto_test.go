@@ -0,0 +1,61 @@
+package proto_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/internal/generator/proto"
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateKeepsFieldNumbers(t *testing.T) {
+	t.Parallel()
+	outputFile := filepath.Join(t.TempDir(), "test.proto")
+	cfg := proto.Config{OutputFile: outputFile, MessageName: "Test"}
+
+	generate := func(definitions string) string {
+		t.Helper()
+		tmplData, err := schema.GetDefinedSignals(strings.NewReader(schema.VssRel42DIMO()), strings.NewReader(definitions))
+		require.NoError(t, err)
+		require.NoError(t, proto.Generate(tmplData, cfg))
+		data, err := os.ReadFile(outputFile)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	protoFile := generate(`
+- vspecName: Vehicle.Speed
+- vspecName: Vehicle.Powertrain.Type
+- vspecName: Vehicle.Powertrain.FuelSystem.SupportedFuelTypes
+`)
+	require.Contains(t, protoFile, "message TestSnapshot {")
+	require.Contains(t, protoFile, "message TestBatch {")
+	require.Contains(t, protoFile, "  repeated string powertrain_fuel_system_supported_fuel_types = 16;\n")
+	require.Contains(t, protoFile, "  optional string powertrain_type = 17;\n")
+	require.Contains(t, protoFile, "  optional double speed = 18;\n")
+	require.NotContains(t, protoFile, "  reserved ")
+
+	// Remove the fuel types and add the engine speed, existing numbers must not change.
+	protoFile = generate(`
+- vspecName: Vehicle.Speed
+- vspecName: Vehicle.Powertrain.Type
+- vspecName: Vehicle.Powertrain.CombustionEngine.Speed
+`)
+	require.Contains(t, protoFile, "  reserved 16;\n")
+	require.Contains(t, protoFile, "  optional string powertrain_type = 17;\n")
+	require.Contains(t, protoFile, "  optional double speed = 18;\n")
+	require.Contains(t, protoFile, "  optional uint32 powertrain_combustion_engine_speed = 19;\n")
+	require.NotContains(t, protoFile, "supported_fuel_types")
+
+	// Re-adding a removed signal gets a new number since the old one is reserved.
+	protoFile = generate(`
+- vspecName: Vehicle.Speed
+- vspecName: Vehicle.Powertrain.FuelSystem.SupportedFuelTypes
+`)
+	require.Contains(t, protoFile, "  reserved 16, 17, 19;\n")
+	require.Contains(t, protoFile, "  optional double speed = 18;\n")
+	require.Contains(t, protoFile, "  repeated string powertrain_fuel_system_supported_fuel_types = 20;\n")
+}
@@ -63,11 +63,11 @@ func TokenIDFromData(jsonData []byte) (uint32, error) {
 	if !ok {
 		return 0, fmt.Errorf("%s field is not a string", lookupKey)
 	}
-	subjectDID, err := cloudevent.DecodeNFTDID(subjectStr)
+	tokenID, err := cloudevent.TokenIDFromDID(subjectStr)
 	if err != nil {
 		return 0, fmt.Errorf("error decoding subject: %w", err)
 	}
-	return subjectDID.TokenID, nil
+	return tokenID, nil
 }
 
 // SourceFromData gets a source from a V2 payload.
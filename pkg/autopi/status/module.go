@@ -0,0 +1,89 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/DIMO-Network/model-garage/pkg/autopi"
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+	"github.com/DIMO-Network/model-garage/pkg/modules"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/tidwall/gjson"
+)
+
+// ModuleName is the name the AutoPi module is registered under.
+// The AutoPi data version "v2" is too generic to route on, so AutoPi sources must be routed with modules.RegisterSource.
+const ModuleName = "autopi"
+
+func init() {
+	modules.Register(ModuleName, &Module{})
+}
+
+// ModuleConfig holds the settings needed to convert raw AutoPi messages into CloudEvents.
+type ModuleConfig struct {
+	ChainID                 uint64 `json:"chainId"`
+	AftermarketContractAddr string `json:"aftermarketContractAddr"`
+	VehicleContractAddr     string `json:"vehicleContractAddr"`
+}
+
+// Module is the AutoPi source module.
+// SetConfig must be called before CloudEventConvert.
+// A Module must not be copied after first use.
+type Module struct {
+	config ModuleConfig
+	mu     sync.RWMutex
+}
+
+// SetConfig sets the module configuration from a JSON encoded ModuleConfig.
+// It is safe to call while the module is converting messages.
+func (m *Module) SetConfig(config string) error {
+	var cfg ModuleConfig
+	if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = cfg
+	return nil
+}
+
+// SignalConvert converts an AutoPi status CloudEvent into a slice of signals.
+func (m *Module) SignalConvert(_ context.Context, msgData []byte) ([]vss.Signal, error) {
+	version := autopi.GetDataVersion(msgData)
+	switch {
+	case version == autopi.DataVersion:
+		return SignalsFromV2Payload(msgData)
+	case autopi.HasV1Data(version):
+		return SignalsFromV1Payload(msgData)
+	default:
+		return nil, convert.VersionError{Version: version}
+	}
+}
+
+// FingerprintConvert converts an AutoPi fingerprint CloudEvent into a fingerprint.
+func (m *Module) FingerprintConvert(_ context.Context, msgData []byte) (cloudevent.Fingerprint, error) {
+	result := gjson.GetBytes(msgData, "data.vin")
+	if !result.Exists() {
+		return cloudevent.Fingerprint{}, convert.FieldNotFoundError{Field: "vin", Lookup: "data.vin"}
+	}
+	if result.Type != gjson.String {
+		return cloudevent.Fingerprint{}, fmt.Errorf("vin field is not a string")
+	}
+	return cloudevent.Fingerprint{VIN: result.String()}, nil
+}
+
+// EventConvert is not supported by AutoPi.
+func (m *Module) EventConvert(context.Context, []byte) ([]vss.Event, error) {
+	return nil, modules.UnsupportedError{Module: ModuleName, Operation: modules.OperationEvents}
+}
+
+// CloudEventConvert converts a raw AutoPi message into CloudEvents for the vehicle and the device.
+func (m *Module) CloudEventConvert(_ context.Context, msgData []byte) ([][]byte, error) {
+	m.mu.RLock()
+	cfg := m.config
+	m.mu.RUnlock()
+	return autopi.ConvertToCloudEvents(msgData, cfg.ChainID, cfg.AftermarketContractAddr, cfg.VehicleContractAddr)
+}
@@ -80,26 +80,26 @@ var (
 
 	expectedSignals = []vss.Signal{
 		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow1WheelLeftTirePressure", ValueNumber: 30.5, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow1WheelRightTirePressure", ValueNumber: 31, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow1WheelRightTirePressure", ValueNumber: 31, ValueInt: 31, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow2WheelLeftTirePressure", ValueNumber: 32.2, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow2WheelRightTirePressure", ValueNumber: 33.1, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryCurrentPower", ValueNumber: 34000.0, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "currentLocationAltitude", ValueNumber: 100, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "currentLocationLatitude", ValueNumber: 37.7749, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "currentLocationLongitude", ValueNumber: -122.4194, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "currentLocationIsRedacted", ValueNumber: 1, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineECT", ValueNumber: 90, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "currentLocationIsRedacted", ValueNumber: 1, ValueBool: true, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineECT", ValueNumber: 90, ValueInt: 90, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineEngineOilLevel", ValueString: "CRITICALLY_LOW", Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineEngineOilRelativeLevel", ValueNumber: 10, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineSpeed", ValueNumber: 3000, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineTPS", ValueNumber: 50, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainFuelSystemRelativeLevel", ValueNumber: 60, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainFuelSystemSupportedFuelTypes", ValueString: "GASOLINE", Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainRange", ValueNumber: 300, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineSpeed", ValueNumber: 3000, ValueInt: 3000, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineTPS", ValueNumber: 50, ValueInt: 50, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainFuelSystemRelativeLevel", ValueNumber: 60, ValueInt: 60, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainFuelSystemSupportedFuelTypes", ValueString: "GASOLINE", ValueStringArray: []string{"GASOLINE"}, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainRange", ValueNumber: 300, ValueInt: 300, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainType", ValueString: "COMBUSTION", Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryChargingChargeLimit", ValueNumber: 80, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryChargingIsCharging", ValueNumber: 1, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryGrossCapacity", ValueNumber: 60, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryChargingChargeLimit", ValueNumber: 80, ValueInt: 80, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryChargingIsCharging", ValueNumber: 1, ValueBool: true, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryGrossCapacity", ValueNumber: 60, ValueInt: 60, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryStateOfChargeCurrent", ValueNumber: 70, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainTransmissionTravelledDistance", ValueNumber: 50000, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "speed", ValueNumber: 60, Source: "dimo/integration/123"},
@@ -4,6 +4,7 @@ package autopi
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/DIMO-Network/model-garage/pkg/convert"
 	"github.com/DIMO-Network/model-garage/pkg/vss"
@@ -23,7 +24,9 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 	case "altitude":
 		val0, err := CurrentLocationAltitudeFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'altitude': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'altitude': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -31,13 +34,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "currentLocationAltitude",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("double", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'altitude': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "ambientAirTemp":
 		val0, err := ExteriorAirTemperatureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'ambientAirTemp': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'ambientAirTemp': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -45,13 +53,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "exteriorAirTemperature",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'ambientAirTemp': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "ambientTemp":
 		val0, err := ExteriorAirTemperatureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'ambientTemp': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'ambientTemp': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -59,13 +72,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "exteriorAirTemperature",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'ambientTemp': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "atfTemperature":
 		val0, err := PowertrainTransmissionTemperatureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'atfTemperature': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'atfTemperature': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -73,13 +91,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTransmissionTemperature",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("int16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'atfTemperature': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "barometricPressure":
 		val0, err := OBDBarometricPressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'barometricPressure': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'barometricPressure': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -87,13 +110,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdBarometricPressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'barometricPressure': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "batteryCapacity":
 		val0, err := PowertrainTractionBatteryGrossCapacityFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'batteryCapacity': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'batteryCapacity': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -101,13 +129,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTractionBatteryGrossCapacity",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'batteryCapacity': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "batteryVoltage":
 		val0, err := LowVoltageBatteryCurrentVoltageFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'batteryVoltage': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'batteryVoltage': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -115,13 +148,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "lowVoltageBatteryCurrentVoltage",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'batteryVoltage': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "chargeLimit":
 		val0, err := PowertrainTractionBatteryChargingChargeLimitFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'chargeLimit': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'chargeLimit': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -129,13 +167,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTractionBatteryChargingChargeLimit",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint8", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'chargeLimit': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "charger.power":
 		val0, err := PowertrainTractionBatteryCurrentPowerFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'charger.power': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'charger.power': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -143,13 +186,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTractionBatteryCurrentPower",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'charger.power': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "charging":
 		val0, err := PowertrainTractionBatteryChargingIsChargingFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'charging': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'charging': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -157,13 +205,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTractionBatteryChargingIsCharging",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("boolean", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'charging': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "commandedEgr":
 		val0, err := OBDCommandedEGRFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'commandedEgr': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'commandedEgr': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -171,13 +224,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdCommandedEGR",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'commandedEgr': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "coolantTemp":
 		val0, err := PowertrainCombustionEngineECTFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'coolantTemp': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'coolantTemp': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -185,13 +243,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineECT",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("int16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'coolantTemp': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "distanceSinceDtcClear":
 		val0, err := OBDDistanceSinceDTCClearFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'distanceSinceDtcClear': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'distanceSinceDtcClear': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -199,13 +262,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdDistanceSinceDTCClear",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'distanceSinceDtcClear': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "distanceWMil":
 		val0, err := OBDDistanceWithMILFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'distanceWMil': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'distanceWMil': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -213,13 +281,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdDistanceWithMIL",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'distanceWMil': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "engineLoad":
 		val0, err := OBDEngineLoadFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'engineLoad': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'engineLoad': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -227,13 +300,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdEngineLoad",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'engineLoad': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "engineSpeed":
 		val0, err := PowertrainCombustionEngineSpeedFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'engineSpeed': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'engineSpeed': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -241,13 +319,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineSpeed",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'engineSpeed': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "engineTorque":
 		val0, err := PowertrainCombustionEngineTorqueFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'engineTorque': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'engineTorque': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -255,13 +338,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineTorque",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'engineTorque': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "evap":
 		val0, err := OBDCommandedEVAPFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'evap': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'evap': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -269,13 +357,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdCommandedEVAP",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'evap': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "frontRightWheelSpeed":
 		val0, err := ChassisAxleRow1WheelRightSpeedFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'frontRightWheelSpeed': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'frontRightWheelSpeed': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -283,13 +376,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow1WheelRightSpeed",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'frontRightWheelSpeed': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "frontlLeftWheelSpeed":
 		val0, err := ChassisAxleRow1WheelLeftSpeedFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'frontlLeftWheelSpeed': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'frontlLeftWheelSpeed': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -297,13 +395,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow1WheelLeftSpeed",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'frontlLeftWheelSpeed': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "fuelLevel":
 		val0, err := PowertrainFuelSystemRelativeLevelFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelLevel': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelLevel': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -311,13 +414,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainFuelSystemRelativeLevel",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint8", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'fuelLevel': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "fuelLevelLiters":
 		val0, err := PowertrainFuelSystemAbsoluteLevelFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelLevelLiters': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelLevelLiters': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -325,13 +433,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainFuelSystemAbsoluteLevel",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'fuelLevelLiters': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "fuelPercentRemaining":
 		val0, err := PowertrainFuelSystemRelativeLevelFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelPercentRemaining': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelPercentRemaining': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -339,13 +452,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainFuelSystemRelativeLevel",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint8", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'fuelPercentRemaining': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "fuelTankPressure":
 		val0, err := OBDFuelPressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelTankPressure': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelTankPressure': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -353,13 +471,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdFuelPressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'fuelTankPressure': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "fuelType":
 		val0, err := PowertrainFuelSystemSupportedFuelTypesFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelType': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelType': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -367,12 +490,16 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainFuelSystemSupportedFuelTypes",
 			}
-			sig.SetValue(val0)
+			// conversions return a single array element, which is also kept as the string value
+			sig.SetValue([]string{val0})
+			sig.ValueString = fmt.Sprint(val0)
 			ret = append(ret, sig)
 		}
 		val1, err := PowertrainTypeFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelType': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'fuelType': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -380,13 +507,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainType",
 			}
-			sig.SetValue(val1)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("string", val1); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'fuelType': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "gearSelection":
 		val0, err := PowertrainTransmissionCurrentGearFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'gearSelection': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'gearSelection': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -394,13 +526,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTransmissionCurrentGear",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("int8", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'gearSelection': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "hdop":
 		val0, err := DIMOAftermarketHDOPFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'hdop': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'hdop': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -408,13 +545,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "dimoAftermarketHDOP",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'hdop': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "hvBatteryCoolantTemperature":
 		val0, err := PowertrainTractionBatteryTemperatureAverageFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'hvBatteryCoolantTemperature': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'hvBatteryCoolantTemperature': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -422,13 +564,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTractionBatteryTemperatureAverage",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'hvBatteryCoolantTemperature': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "hvBatteryVoltage":
 		val0, err := PowertrainTractionBatteryCurrentVoltageFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'hvBatteryVoltage': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'hvBatteryVoltage': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -436,13 +583,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTractionBatteryCurrentVoltage",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'hvBatteryVoltage': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "intakePressure":
 		val0, err := OBDMAPFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'intakePressure': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'intakePressure': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -450,13 +602,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdMAP",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'intakePressure': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "intakeTemp":
 		val0, err := OBDIntakeTempFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'intakeTemp': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'intakeTemp': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -464,13 +621,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdIntakeTemp",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'intakeTemp': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "isRedacted":
 		val0, err := CurrentLocationIsRedactedFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'isRedacted': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'isRedacted': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -478,13 +640,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "currentLocationIsRedacted",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("boolean", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'isRedacted': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "latitude":
 		val0, err := CurrentLocationLatitudeFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'latitude': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'latitude': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -492,13 +659,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "currentLocationLatitude",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("double", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'latitude': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "longTermFuelTrim1":
 		val0, err := OBDLongTermFuelTrim1FromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'longTermFuelTrim1': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'longTermFuelTrim1': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -506,13 +678,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdLongTermFuelTrim1",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'longTermFuelTrim1': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "longitude":
 		val0, err := CurrentLocationLongitudeFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'longitude': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'longitude': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -520,13 +697,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "currentLocationLongitude",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("double", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'longitude': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "maf":
 		val0, err := PowertrainCombustionEngineMAFFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'maf': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'maf': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -534,13 +716,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineMAF",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'maf': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "nsat":
 		val0, err := DIMOAftermarketNSATFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'nsat': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'nsat': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -548,13 +735,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "dimoAftermarketNSAT",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'nsat': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "odometer":
 		val0, err := PowertrainTransmissionTravelledDistanceFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'odometer': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'odometer': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -562,13 +754,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTransmissionTravelledDistance",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'odometer': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "oil":
 		val0, err := PowertrainCombustionEngineEngineOilLevelFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oil': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oil': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -576,12 +773,17 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineEngineOilLevel",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("string", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'oil': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 		val1, err := PowertrainCombustionEngineEngineOilRelativeLevelFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oil': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oil': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -589,13 +791,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineEngineOilRelativeLevel",
 			}
-			sig.SetValue(val1)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val1); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'oil': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "oilLife":
 		val0, err := PowertrainCombustionEngineEngineOilLevelFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oilLife': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oilLife': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -603,13 +810,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineEngineOilLevel",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("string", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'oilLife': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "oxygenSensor1":
 		val0, err := OBDO2WRSensor1VoltageFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oxygenSensor1': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oxygenSensor1': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -617,13 +829,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdO2WRSensor1Voltage",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'oxygenSensor1': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "oxygenSensor2":
 		val0, err := OBDO2WRSensor2VoltageFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oxygenSensor2': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'oxygenSensor2': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -631,13 +848,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdO2WRSensor2Voltage",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'oxygenSensor2': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "range":
 		val0, err := PowertrainRangeFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'range': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'range': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -645,13 +867,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainRange",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint32", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'range': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "rpm":
 		val0, err := PowertrainCombustionEngineSpeedFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'rpm': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'rpm': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -659,13 +886,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineSpeed",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'rpm': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "runTime":
 		val0, err := OBDRunTimeFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'runTime': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'runTime': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -673,13 +905,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdRunTime",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'runTime': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "serviceInterval":
 		val0, err := ServiceDistanceToServiceFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'serviceInterval': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'serviceInterval': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -687,13 +924,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "serviceDistanceToService",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'serviceInterval': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "shortTermFuelTrim1":
 		val0, err := OBDShortTermFuelTrim1FromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'shortTermFuelTrim1': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'shortTermFuelTrim1': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -701,13 +943,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdShortTermFuelTrim1",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'shortTermFuelTrim1': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "soc":
 		val0, err := PowertrainTractionBatteryStateOfChargeCurrentFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'soc': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'soc': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -715,13 +962,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainTractionBatteryStateOfChargeCurrent",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'soc': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "speed":
 		val0, err := SpeedFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'speed': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'speed': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -729,13 +981,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "speed",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'speed': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "ssid":
 		val0, err := DIMOAftermarketSSIDFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'ssid': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'ssid': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -743,13 +1000,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "dimoAftermarketSSID",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("string", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'ssid': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "throttlePosition":
 		val0, err := PowertrainCombustionEngineTPSFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'throttlePosition': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'throttlePosition': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -757,13 +1019,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "powertrainCombustionEngineTPS",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint8", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'throttlePosition': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "tires.backLeft":
 		val0, err := ChassisAxleRow2WheelLeftTirePressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tires.backLeft': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tires.backLeft': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -771,13 +1038,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow2WheelLeftTirePressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'tires.backLeft': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "tires.backRight":
 		val0, err := ChassisAxleRow2WheelRightTirePressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tires.backRight': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tires.backRight': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -785,13 +1057,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow2WheelRightTirePressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'tires.backRight': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "tires.frontLeft":
 		val0, err := ChassisAxleRow1WheelLeftTirePressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tires.frontLeft': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tires.frontLeft': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -799,13 +1076,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow1WheelLeftTirePressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'tires.frontLeft': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "tires.frontRight":
 		val0, err := ChassisAxleRow1WheelRightTirePressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tires.frontRight': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tires.frontRight': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -813,13 +1095,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow1WheelRightTirePressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'tires.frontRight': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "tiresBackLeft":
 		val0, err := ChassisAxleRow2WheelLeftTirePressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tiresBackLeft': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tiresBackLeft': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -827,13 +1114,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow2WheelLeftTirePressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'tiresBackLeft': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "tiresBackRight":
 		val0, err := ChassisAxleRow2WheelRightTirePressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tiresBackRight': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tiresBackRight': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -841,13 +1133,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow2WheelRightTirePressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'tiresBackRight': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "tiresFrontLeft":
 		val0, err := ChassisAxleRow1WheelLeftTirePressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tiresFrontLeft': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tiresFrontLeft': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -855,13 +1152,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow1WheelLeftTirePressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'tiresFrontLeft': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "tiresFrontRight":
 		val0, err := ChassisAxleRow1WheelRightTirePressureFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tiresFrontRight': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'tiresFrontRight': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -869,13 +1171,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "chassisAxleRow1WheelRightTirePressure",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint16", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'tiresFrontRight': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "vehicleSpeed":
 		val0, err := SpeedFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'vehicleSpeed': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'vehicleSpeed': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -883,13 +1190,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "speed",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'vehicleSpeed': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "warmupsSinceDtcClear":
 		val0, err := OBDWarmupsSinceDTCClearFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'warmupsSinceDtcClear': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'warmupsSinceDtcClear': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -897,13 +1209,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "obdWarmupsSinceDTCClear",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("uint8", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'warmupsSinceDtcClear': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "wifi.ssid":
 		val0, err := DIMOAftermarketSSIDFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'wifi.ssid': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'wifi.ssid': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -911,13 +1228,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "dimoAftermarketSSID",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("string", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'wifi.ssid': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "wifi.wpaState":
 		val0, err := DIMOAftermarketWPAStateFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'wifi.wpaState': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'wifi.wpaState': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -925,13 +1247,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "dimoAftermarketWPAState",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("string", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'wifi.wpaState': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "wpa_state":
 		val0, err := DIMOAftermarketWPAStateFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'wpa_state': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'wpa_state': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -939,13 +1266,18 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "dimoAftermarketWPAState",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("string", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'wpa_state': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	case "yawRate":
 		val0, err := AngularVelocityYawFromV2Data(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'yawRate': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'yawRate': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -953,8 +1285,11 @@ func SignalsFromV2Data(originalDoc []byte, baseSignal vss.Signal, signalName str
 				Source:    baseSignal.Source,
 				Name:      "angularVelocityYaw",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'yawRate': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 	default:
 		// do nothing
@@ -1161,6 +1496,9 @@ func CurrentLocationLatitudeFromV2Data(originalDoc []byte, result gjson.Result)
 	val0, ok := result.Value().(float64)
 	if ok {
 		ret, err = ToCurrentLocationLatitude0(originalDoc, val0)
+		if err == nil {
+			ret, err = convert.ApplyRange("error", val0, ret, -90, 90)
+		}
 		if err == nil {
 			return ret, nil
 		}
@@ -1178,6 +1516,9 @@ func CurrentLocationLongitudeFromV2Data(originalDoc []byte, result gjson.Result)
 	val0, ok := result.Value().(float64)
 	if ok {
 		ret, err = ToCurrentLocationLongitude0(originalDoc, val0)
+		if err == nil {
+			ret, err = convert.ApplyRange("error", val0, ret, -180, 180)
+		}
 		if err == nil {
 			return ret, nil
 		}
@@ -1599,6 +1940,9 @@ func PowertrainCombustionEngineEngineOilLevelFromV2Data(originalDoc []byte, resu
 	val0, ok := result.Value().(float64)
 	if ok {
 		ret, err = ToPowertrainCombustionEngineEngineOilLevel0(originalDoc, val0)
+		if err == nil {
+			err = convert.CheckAllowed(ret, "CRITICALLY_LOW", "LOW", "NORMAL", "HIGH", "CRITICALLY_HIGH")
+		}
 		if err == nil {
 			return ret, nil
 		}
@@ -1609,6 +1953,9 @@ func PowertrainCombustionEngineEngineOilLevelFromV2Data(originalDoc []byte, resu
 	val1, ok := result.Value().(float64)
 	if ok {
 		ret, err = ToPowertrainCombustionEngineEngineOilLevel1(originalDoc, val1)
+		if err == nil {
+			err = convert.CheckAllowed(ret, "CRITICALLY_LOW", "LOW", "NORMAL", "HIGH", "CRITICALLY_HIGH")
+		}
 		if err == nil {
 			return ret, nil
 		}
@@ -1765,6 +2112,9 @@ func PowertrainFuelSystemSupportedFuelTypesFromV2Data(originalDoc []byte, result
 	val0, ok := result.Value().(string)
 	if ok {
 		ret, err = ToPowertrainFuelSystemSupportedFuelTypes0(originalDoc, val0)
+		if err == nil {
+			err = convert.CheckAllowed(ret, "GASOLINE", "DIESEL", "E85", "LPG", "CNG", "LNG", "H2", "OTHER")
+		}
 		if err == nil {
 			return ret, nil
 		}
@@ -1952,6 +2302,9 @@ func PowertrainTransmissionTravelledDistanceFromV2Data(originalDoc []byte, resul
 	val0, ok := result.Value().(float64)
 	if ok {
 		ret, err = ToPowertrainTransmissionTravelledDistance0(originalDoc, val0)
+		if err == nil {
+			ret, err = convert.ApplyRange("error", val0, ret, 0, math.Inf(1))
+		}
 		if err == nil {
 			return ret, nil
 		}
@@ -1969,6 +2322,9 @@ func PowertrainTypeFromV2Data(originalDoc []byte, result gjson.Result) (ret stri
 	val0, ok := result.Value().(string)
 	if ok {
 		ret, err = ToPowertrainType0(originalDoc, val0)
+		if err == nil {
+			err = convert.CheckAllowed(ret, "COMBUSTION", "HYBRID", "ELECTRIC")
+		}
 		if err == nil {
 			return ret, nil
 		}
@@ -0,0 +1,187 @@
+package cloudevent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// BatchContentType is the content type of a batch of CloudEvents in JSON format.
+const BatchContentType = "application/cloudevents-batch+json"
+
+// errBatchClosed is returned when writing to a BatchEncoder that has been closed.
+var errBatchClosed = errors.New("batch encoder is closed")
+
+// Batch is a list of CloudEvents sent together, such as events buffered by a device while offline.
+// See https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/formats/json-format.md#4-json-batch-format
+type Batch[A any] []CloudEvent[A]
+
+// MarshalJSON implements custom JSON marshaling for Batch so that an empty batch is encoded as an empty array.
+func (b Batch[A]) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]CloudEvent[A](b))
+}
+
+// BatchEventError is returned when a single event of a batch cannot be decoded.
+// The remaining events of the batch can still be read.
+type BatchEventError struct {
+	// Index is the position of the event in the batch.
+	Index int
+	Err   error
+}
+
+func (e BatchEventError) Error() string {
+	return fmt.Sprintf("error decoding batch event %d: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the error that occurred while decoding the event.
+func (e BatchEventError) Unwrap() error {
+	return e.Err
+}
+
+// BatchDecoder reads the events of a batch one at a time without reading the whole batch into memory.
+type BatchDecoder[A any] struct {
+	dec     *json.Decoder
+	started bool
+	index   int
+	err     error
+}
+
+// NewBatchDecoder returns a decoder that reads a batch from r.
+func NewBatchDecoder[A any](r io.Reader) *BatchDecoder[A] {
+	return &BatchDecoder[A]{dec: json.NewDecoder(r)}
+}
+
+// Next returns the next event of the batch, or io.EOF when there are no more events.
+// If the event is valid JSON but not a valid CloudEvent a BatchEventError is returned and Next may be called again.
+// Any other error means the batch is malformed and is returned by all later calls.
+func (d *BatchDecoder[A]) Next() (CloudEvent[A], error) {
+	var event CloudEvent[A]
+	raw, err := d.NextRaw()
+	if err != nil {
+		return event, err
+	}
+	if err := json.Unmarshal(raw, &event); err != nil {
+		return event, BatchEventError{Index: d.index - 1, Err: err}
+	}
+	return event, nil
+}
+
+// NextRaw returns the JSON of the next event of the batch, or io.EOF when there are no more events.
+func (d *BatchDecoder[A]) NextRaw() (json.RawMessage, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	if !d.started {
+		if err := d.expectDelim('['); err != nil {
+			d.err = err
+			return nil, err
+		}
+		d.started = true
+	}
+	if !d.dec.More() {
+		if err := d.expectDelim(']'); err != nil {
+			d.err = err
+			return nil, err
+		}
+		d.err = io.EOF
+		return nil, io.EOF
+	}
+	var raw json.RawMessage
+	if err := d.dec.Decode(&raw); err != nil {
+		d.err = fmt.Errorf("failed to read batch event %d: %w", d.index, err)
+		return nil, d.err
+	}
+	d.index++
+	return raw, nil
+}
+
+func (d *BatchDecoder[A]) expectDelim(delim json.Delim) error {
+	tok, err := d.dec.Token()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return fmt.Errorf("failed to read batch: %w", err)
+	}
+	if tok != delim {
+		return fmt.Errorf("failed to read batch: expected '%v' but found '%v'", delim, tok)
+	}
+	return nil
+}
+
+// DecodeBatch reads a whole batch from r.
+// Events that cannot be decoded are skipped and their BatchEventErrors are returned joined along with the other events.
+// If the batch is malformed its error is also joined and the events read before it are returned.
+func DecodeBatch[A any](r io.Reader) (Batch[A], error) {
+	dec := NewBatchDecoder[A](r)
+	batch := Batch[A]{}
+	var errs error
+	for {
+		event, err := dec.Next()
+		if errors.Is(err, io.EOF) {
+			return batch, errs
+		}
+		var eventErr BatchEventError
+		if errors.As(err, &eventErr) {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if err != nil {
+			return batch, errors.Join(errs, err)
+		}
+		batch = append(batch, event)
+	}
+}
+
+// BatchEncoder writes a batch one event at a time.
+// Close must be called to finish the batch.
+type BatchEncoder[A any] struct {
+	w      io.Writer
+	count  int
+	closed bool
+}
+
+// NewBatchEncoder returns an encoder that writes a batch to w.
+func NewBatchEncoder[A any](w io.Writer) *BatchEncoder[A] {
+	return &BatchEncoder[A]{w: w}
+}
+
+// Encode writes the event to the batch.
+func (e *BatchEncoder[A]) Encode(event CloudEvent[A]) error {
+	if e.closed {
+		return errBatchClosed
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal batch event %d: %w", e.count, err)
+	}
+	sep := []byte{','}
+	if e.count == 0 {
+		sep = []byte{'['}
+	}
+	if _, err := e.w.Write(append(sep, data...)); err != nil {
+		return fmt.Errorf("failed to write batch event %d: %w", e.count, err)
+	}
+	e.count++
+	return nil
+}
+
+// Close finishes the batch. An empty batch is written as an empty array.
+func (e *BatchEncoder[A]) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	end := []byte{']'}
+	if e.count == 0 {
+		end = []byte("[]")
+	}
+	if _, err := e.w.Write(end); err != nil {
+		return fmt.Errorf("failed to write batch: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package cloudevent_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/stretchr/testify/require"
+)
+
+type batchData struct {
+	Speed float64 `json:"speed"`
+}
+
+func TestBatchRoundTrip(t *testing.T) {
+	t.Parallel()
+	events := cloudevent.Batch[batchData]{
+		{
+			CloudEventHeader: cloudevent.CloudEventHeader{
+				ID:          "1",
+				Source:      "0xF26421509Efe92861a587482100c6d728aBf1CD0",
+				SpecVersion: cloudevent.SpecVersion,
+				Time:        time.Date(2024, 11, 1, 12, 0, 0, 0, time.UTC),
+				Type:        cloudevent.TypeStatus,
+				Extras:      map[string]any{"signature": "0x00"},
+			},
+			Data: batchData{Speed: 10},
+		},
+		{
+			CloudEventHeader: cloudevent.CloudEventHeader{
+				ID:          "2",
+				Source:      "0xF26421509Efe92861a587482100c6d728aBf1CD0",
+				SpecVersion: cloudevent.SpecVersion,
+				Time:        time.Date(2024, 11, 1, 12, 0, 1, 0, time.UTC),
+				Type:        cloudevent.TypeStatus,
+			},
+			Data: batchData{Speed: 20},
+		},
+	}
+
+	var buf bytes.Buffer
+	enc := cloudevent.NewBatchEncoder[batchData](&buf)
+	for _, event := range events {
+		require.NoError(t, enc.Encode(event))
+	}
+	require.NoError(t, enc.Close())
+	require.Error(t, enc.Encode(events[0]), "encode after close")
+
+	marshaled, err := json.Marshal(events)
+	require.NoError(t, err)
+	require.JSONEq(t, string(marshaled), buf.String())
+
+	decoded, err := cloudevent.DecodeBatch[batchData](&buf)
+	require.NoError(t, err)
+	require.Equal(t, events, decoded)
+}
+
+func TestEmptyBatch(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	enc := cloudevent.NewBatchEncoder[batchData](&buf)
+	require.NoError(t, enc.Close())
+	require.Equal(t, "[]", buf.String())
+
+	marshaled, err := json.Marshal(cloudevent.Batch[batchData](nil))
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(marshaled))
+
+	decoded, err := cloudevent.DecodeBatch[batchData](&buf)
+	require.NoError(t, err)
+	require.Empty(t, decoded)
+}
+
+func TestDecodeBatchEventErrors(t *testing.T) {
+	t.Parallel()
+	input := `[{"id":"1","data":{"speed":1}},{"id":"2","data":{"speed":"fast"}},{"id":"3","data":{"speed":3}},{"id":"4","data":[]}]`
+	decoded, err := cloudevent.DecodeBatch[batchData](strings.NewReader(input))
+	require.Len(t, decoded, 2)
+	require.Equal(t, "1", decoded[0].ID)
+	require.Equal(t, "3", decoded[1].ID)
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	require.True(t, ok, "expected joined errors")
+	var indexes []int
+	for _, err := range joined.Unwrap() {
+		var eventErr cloudevent.BatchEventError
+		require.ErrorAs(t, err, &eventErr)
+		indexes = append(indexes, eventErr.Index)
+	}
+	require.Equal(t, []int{1, 3}, indexes)
+
+	decoded, err = cloudevent.DecodeBatch[batchData](strings.NewReader(`[{"id":"1"},{"id":`))
+	require.Error(t, err)
+	require.Len(t, decoded, 1)
+}
+
+func TestBatchDecoder(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		input         string
+		expectedIDs   []string
+		eventErrIndex int
+		malformed     bool
+	}{
+		{
+			name:          "event error continues",
+			input:         `[{"id":"1","data":{"speed":1}},{"id":"2","data":{"speed":"fast"}},{"id":"3","data":{"speed":3}}]`,
+			expectedIDs:   []string{"1", "3"},
+			eventErrIndex: 1,
+		},
+		{
+			name:          "not an array",
+			input:         `{"id":"1"}`,
+			eventErrIndex: -1,
+			malformed:     true,
+		},
+		{
+			name:          "truncated",
+			input:         `[{"id":"1"},{"id":`,
+			expectedIDs:   []string{"1"},
+			eventErrIndex: -1,
+			malformed:     true,
+		},
+		{
+			name:          "missing end",
+			input:         `[{"id":"1"}`,
+			expectedIDs:   []string{"1"},
+			eventErrIndex: -1,
+			malformed:     true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dec := cloudevent.NewBatchDecoder[batchData](strings.NewReader(tt.input))
+			var ids []string
+			eventErrIndex := -1
+			for {
+				event, err := dec.Next()
+				if errors.Is(err, io.EOF) {
+					require.False(t, tt.malformed, "expected malformed batch error")
+					break
+				}
+				var eventErr cloudevent.BatchEventError
+				if errors.As(err, &eventErr) {
+					eventErrIndex = eventErr.Index
+					continue
+				}
+				if err != nil {
+					require.True(t, tt.malformed, "unexpected error: %v", err)
+					_, nextErr := dec.Next()
+					require.Equal(t, err, nextErr, "malformed batch error is sticky")
+					break
+				}
+				ids = append(ids, event.ID)
+			}
+			require.Equal(t, tt.expectedIDs, ids)
+			require.Equal(t, tt.eventErrIndex, eventErrIndex)
+		})
+	}
+}
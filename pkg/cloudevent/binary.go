@@ -0,0 +1,270 @@
+package cloudevent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// HTTPHeaderPrefix is the prefix of CloudEvent attribute headers in HTTP binary content mode.
+	HTTPHeaderPrefix = "ce-"
+	// KafkaHeaderPrefix is the prefix of CloudEvent attribute headers in Kafka binary content mode.
+	KafkaHeaderPrefix = "ce_"
+
+	httpContentTypeHeader  = "Content-Type"
+	kafkaContentTypeHeader = "content-type"
+)
+
+// errMissingSpecVersion is returned when decoding headers that are not a binary mode CloudEvent.
+var errMissingSpecVersion = errors.New("missing specversion header, message is not a binary mode CloudEvent")
+
+// Header is a key/value message header, such as a Kafka record header.
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// EncodeHTTP returns the headers and body of the event in HTTP binary content mode.
+// Attributes are written as ce- headers, Extras as extension attributes and DataContentType as the Content-Type header.
+func EncodeHTTP[A any](event CloudEvent[A]) (http.Header, []byte, error) {
+	body, err := encodeData(event.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	header := http.Header{}
+	err = event.CloudEventHeader.attributes(func(name, value string) {
+		if name == "datacontenttype" {
+			header.Set(httpContentTypeHeader, value)
+			return
+		}
+		header.Set(HTTPHeaderPrefix+name, percentEncode(value))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, body, nil
+}
+
+// DecodeHTTP creates an event from the headers and body of an HTTP binary content mode request.
+func DecodeHTTP[A any](header http.Header, body []byte) (CloudEvent[A], error) {
+	event := CloudEvent[A]{}
+	attrs := map[string]string{}
+	for key, values := range header {
+		if len(values) == 0 {
+			continue
+		}
+		name, ok := cutPrefixFold(key, HTTPHeaderPrefix)
+		if !ok {
+			continue
+		}
+		value, err := url.PathUnescape(values[0])
+		if err != nil {
+			return event, fmt.Errorf("failed to decode header '%s': %w", key, err)
+		}
+		attrs[strings.ToLower(name)] = value
+	}
+	if contentType := header.Get(httpContentTypeHeader); contentType != "" {
+		attrs["datacontenttype"] = contentType
+	}
+	return decodeBinary[A](attrs, body)
+}
+
+// EncodeHeaders returns the headers and body of the event in Kafka binary content mode.
+// Attributes are written as ce_ headers, Extras as extension attributes and DataContentType as the content-type header.
+func EncodeHeaders[A any](event CloudEvent[A]) ([]Header, []byte, error) {
+	body, err := encodeData(event.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	var headers []Header
+	err = event.CloudEventHeader.attributes(func(name, value string) {
+		key := KafkaHeaderPrefix + name
+		if name == "datacontenttype" {
+			key = kafkaContentTypeHeader
+		}
+		headers = append(headers, Header{Key: key, Value: []byte(value)})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return headers, body, nil
+}
+
+// DecodeHeaders creates an event from the headers and body of a Kafka binary content mode record.
+func DecodeHeaders[A any](headers []Header, body []byte) (CloudEvent[A], error) {
+	attrs := map[string]string{}
+	for _, header := range headers {
+		if strings.EqualFold(header.Key, kafkaContentTypeHeader) {
+			attrs["datacontenttype"] = string(header.Value)
+			continue
+		}
+		if name, ok := cutPrefixFold(header.Key, KafkaHeaderPrefix); ok {
+			attrs[strings.ToLower(name)] = string(header.Value)
+		}
+	}
+	return decodeBinary[A](attrs, body)
+}
+
+// attributes calls set with the name and string value of each attribute that is set.
+// Extras that are not strings are written as JSON, which decodeBinary parses back.
+func (c *CloudEventHeader) attributes(set func(name, value string)) error {
+	set("specversion", SpecVersion)
+	setNonEmpty := func(name, value string) {
+		if value != "" {
+			set(name, value)
+		}
+	}
+	setNonEmpty("id", c.ID)
+	setNonEmpty("source", c.Source)
+	setNonEmpty("producer", c.Producer)
+	setNonEmpty("subject", c.Subject)
+	if !c.Time.IsZero() {
+		set("time", c.Time.Format(time.RFC3339Nano))
+	}
+	setNonEmpty("type", c.Type)
+	setNonEmpty("datacontenttype", c.DataContentType)
+	setNonEmpty("dataschema", c.DataSchema)
+	setNonEmpty("dataversion", c.DataVersion)
+	for name, value := range c.Extras {
+		if _, ok := definedCloudeEventHdrFields[name]; ok || name == "data" {
+			continue
+		}
+		if str, ok := value.(string); ok {
+			set(name, str)
+			continue
+		}
+		data, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode extension attribute '%s': %w", name, err)
+		}
+		set(name, string(data))
+	}
+	return nil
+}
+
+// decodeBinary creates an event from attribute values and a body.
+// Unknown attributes are added to Extras, see extensionValue.
+func decodeBinary[A any](attrs map[string]string, body []byte) (CloudEvent[A], error) {
+	event := CloudEvent[A]{}
+	if _, ok := attrs["specversion"]; !ok {
+		return event, errMissingSpecVersion
+	}
+	hdr := &event.CloudEventHeader
+	for name, value := range attrs {
+		switch name {
+		case "specversion":
+			hdr.SpecVersion = value
+		case "id":
+			hdr.ID = value
+		case "source":
+			hdr.Source = value
+		case "producer":
+			hdr.Producer = value
+		case "subject":
+			hdr.Subject = value
+		case "time":
+			t, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return event, fmt.Errorf("failed to parse time attribute: %w", err)
+			}
+			hdr.Time = t
+		case "type":
+			hdr.Type = value
+		case "datacontenttype":
+			hdr.DataContentType = value
+		case "dataschema":
+			hdr.DataSchema = value
+		case "dataversion":
+			hdr.DataVersion = value
+		default:
+			if hdr.Extras == nil {
+				hdr.Extras = map[string]any{}
+			}
+			hdr.Extras[name] = extensionValue(value)
+		}
+	}
+	if err := decodeData(body, &event.Data); err != nil {
+		return event, err
+	}
+	return event, nil
+}
+
+// extensionValue returns the Extras value of an extension attribute.
+// attributes writes Extras that are not strings as JSON, so values that are JSON numbers, booleans, objects or arrays
+// are decoded back to the value json.Unmarshal would give in structured mode.
+// Any other value, including a JSON string, is kept as the header string.
+// As a result a string extension that looks like a JSON number, such as "3", is decoded as a float64.
+func extensionValue(value string) any {
+	var decoded any
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+	switch decoded.(type) {
+	case float64, bool, map[string]any, []any:
+		return decoded
+	}
+	return value
+}
+
+// encodeData returns the body of a binary mode event.
+// []byte and json.RawMessage data is used as is and anything else is encoded as JSON.
+func encodeData(data any) ([]byte, error) {
+	switch typed := data.(type) {
+	case []byte:
+		return typed, nil
+	case json.RawMessage:
+		return typed, nil
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode data: %w", err)
+	}
+	return body, nil
+}
+
+// decodeData sets data from the body of a binary mode event.
+func decodeData(body []byte, data any) error {
+	switch typed := data.(type) {
+	case *[]byte:
+		*typed = body
+		return nil
+	case *json.RawMessage:
+		*typed = body
+		return nil
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, data); err != nil {
+		return fmt.Errorf("failed to decode data: %w", err)
+	}
+	return nil
+}
+
+// percentEncode encodes an HTTP header value as required by the CloudEvents HTTP binding.
+// Bytes outside of printable ASCII, '"' and '%' are percent encoded.
+func percentEncode(value string) string {
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		b := value[i]
+		if b < ' ' || b > '~' || b == '"' || b == '%' {
+			fmt.Fprintf(&builder, "%%%02X", b)
+			continue
+		}
+		_ = builder.WriteByte(b)
+	}
+	return builder.String()
+}
+
+// cutPrefixFold removes a case-insensitive prefix from a header key.
+func cutPrefixFold(key, prefix string) (string, bool) {
+	if len(key) <= len(prefix) || !strings.EqualFold(key[:len(prefix)], prefix) {
+		return "", false
+	}
+	return key[len(prefix):], true
+}
@@ -0,0 +1,122 @@
+package cloudevent_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/stretchr/testify/require"
+)
+
+func newBinaryTestEvent() cloudevent.CloudEvent[TestData] {
+	return cloudevent.CloudEvent[TestData]{
+		CloudEventHeader: cloudevent.CloudEventHeader{
+			ID:              "123",
+			Source:          "test-source",
+			Producer:        "test-producer",
+			SpecVersion:     cloudevent.SpecVersion,
+			Subject:         "did:nft:1:0x123_7",
+			Time:            time.Date(2024, 6, 1, 12, 0, 0, 123456789, time.UTC),
+			Type:            cloudevent.TypeStatus,
+			DataContentType: "application/json",
+			DataVersion:     "v2",
+			Extras:          map[string]any{"signature": "0xabc", "comment": "100% \"ok\" ✓"},
+		},
+		Data: TestData{Message: "hello", Count: 42},
+	}
+}
+
+func TestHTTPBinaryRoundTrip(t *testing.T) {
+	t.Parallel()
+	event := newBinaryTestEvent()
+	header, body, err := cloudevent.EncodeHTTP(event)
+	require.NoError(t, err)
+	require.Equal(t, "123", header.Get("Ce-Id"))
+	require.Equal(t, "application/json", header.Get("Content-Type"))
+	require.Equal(t, "100%25 %22ok%22 %E2%9C%93", header.Get("ce-comment"))
+	require.JSONEq(t, `{"message":"hello","count":42}`, string(body))
+
+	decoded, err := cloudevent.DecodeHTTP[TestData](header, body)
+	require.NoError(t, err)
+	require.Equal(t, event, decoded)
+
+	// the binary form must decode to the same event as the structured form.
+	structured, err := json.Marshal(event)
+	require.NoError(t, err)
+	var fromStructured cloudevent.CloudEvent[TestData]
+	require.NoError(t, json.Unmarshal(structured, &fromStructured))
+	require.Equal(t, fromStructured, decoded)
+}
+
+func TestKafkaBinaryRoundTrip(t *testing.T) {
+	t.Parallel()
+	event := newBinaryTestEvent()
+	headers, body, err := cloudevent.EncodeHeaders(event)
+	require.NoError(t, err)
+	require.Contains(t, headers, cloudevent.Header{Key: "ce_id", Value: []byte("123")})
+	require.Contains(t, headers, cloudevent.Header{Key: "content-type", Value: []byte("application/json")})
+
+	decoded, err := cloudevent.DecodeHeaders[TestData](headers, body)
+	require.NoError(t, err)
+	require.Equal(t, event, decoded)
+
+	structured, err := json.Marshal(event)
+	require.NoError(t, err)
+	var fromStructured cloudevent.CloudEvent[TestData]
+	require.NoError(t, json.Unmarshal(structured, &fromStructured))
+	require.Equal(t, fromStructured, decoded)
+}
+
+func TestBinaryRawData(t *testing.T) {
+	t.Parallel()
+	event := cloudevent.CloudEvent[json.RawMessage]{
+		CloudEventHeader: cloudevent.CloudEventHeader{ID: "1", SpecVersion: cloudevent.SpecVersion},
+		Data:             json.RawMessage(`{"raw":true}`),
+	}
+	headers, body, err := cloudevent.EncodeHeaders(event)
+	require.NoError(t, err)
+	require.Equal(t, `{"raw":true}`, string(body))
+	decoded, err := cloudevent.DecodeHeaders[json.RawMessage](headers, body)
+	require.NoError(t, err)
+	require.Equal(t, event, decoded)
+}
+
+func TestBinaryNonStringExtras(t *testing.T) {
+	t.Parallel()
+	event := cloudevent.CloudEvent[[]byte]{
+		CloudEventHeader: cloudevent.CloudEventHeader{
+			ID: "1",
+			Extras: map[string]any{
+				"count":    3.0,
+				"verified": true,
+				"location": map[string]any{"lat": 1.5},
+				"tags":     []any{"a", "b"},
+				"name":     "null",
+			},
+		},
+		Data: []byte("payload"),
+	}
+	header, body, err := cloudevent.EncodeHTTP(event)
+	require.NoError(t, err)
+	require.Equal(t, "3", header.Get("ce-count"))
+	decoded, err := cloudevent.DecodeHTTP[[]byte](header, body)
+	require.NoError(t, err)
+	require.Equal(t, event.Extras, decoded.Extras)
+	require.Equal(t, []byte("payload"), decoded.Data)
+
+	headers, body, err := cloudevent.EncodeHeaders(event)
+	require.NoError(t, err)
+	decoded, err = cloudevent.DecodeHeaders[[]byte](headers, body)
+	require.NoError(t, err)
+	require.Equal(t, event.Extras, decoded.Extras)
+}
+
+func TestDecodeHTTPNotBinary(t *testing.T) {
+	t.Parallel()
+	header := http.Header{}
+	header.Set("Content-Type", "application/cloudevents+json")
+	_, err := cloudevent.DecodeHTTP[TestData](header, []byte(`{}`))
+	require.Error(t, err)
+}
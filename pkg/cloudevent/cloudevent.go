@@ -176,7 +176,10 @@ func unmarshalCloudEvent(data []byte, dataFunc func(json.RawMessage) error) (Clo
 	if err := json.Unmarshal(data, &aux); err != nil {
 		return c, err
 	}
-	aux.SpecVersion = SpecVersion
+	// A missing specversion defaults to SpecVersion, use ValidateJSON to reject it.
+	if aux.SpecVersion == "" {
+		aux.SpecVersion = SpecVersion
+	}
 	c = (CloudEventHeader)(aux)
 	// Create a map to hold all JSON fields
 	rawFields := make(map[string]json.RawMessage)
@@ -0,0 +1,261 @@
+package cloudevent
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DID is a Decentralized Identifier.
+type DID interface {
+	// Method returns the DID method, such as "nft" for did:nft identifiers.
+	Method() string
+	// String returns the DID string.
+	String() string
+}
+
+// TokenDID is a DID that refers to a token.
+type TokenDID interface {
+	DID
+	// GetTokenID returns the ID of the token the DID refers to.
+	GetTokenID() uint32
+}
+
+// DIDParser parses a DID string of a single method.
+type DIDParser func(did string) (DID, error)
+
+// UnsupportedDIDMethodError is returned when parsing a DID with a method that has no registered parser.
+type UnsupportedDIDMethodError struct {
+	Method string
+}
+
+func (e UnsupportedDIDMethodError) Error() string {
+	return fmt.Sprintf("unsupported DID method '%s'", e.Method)
+}
+
+// NotTokenDIDError is returned when a token ID is requested from a DID that does not refer to a token.
+type NotTokenDIDError struct {
+	DID string
+}
+
+func (e NotTokenDIDError) Error() string {
+	return fmt.Sprintf("DID '%s' does not refer to a token", e.DID)
+}
+
+var (
+	didParsersMu sync.RWMutex
+	didParsers   = map[string]DIDParser{
+		NFTDIDMethod:    didParser(DecodeNFTDID),
+		ERC721DIDMethod: didParser(DecodeERC721DID),
+		EthrDIDMethod:   didParser(DecodeEthrDID),
+		VINDIDMethod:    didParser(DecodeVINDID),
+	}
+)
+
+// didParser adapts a decode function of a concrete DID type to a DIDParser.
+func didParser[T DID](decode func(string) (T, error)) DIDParser {
+	return func(did string) (DID, error) {
+		decoded, err := decode(did)
+		if err != nil {
+			return nil, err
+		}
+		return decoded, nil
+	}
+}
+
+// RegisterDIDMethod adds a parser for DIDs of the given method.
+// RegisterDIDMethod panics if the method is already registered.
+func RegisterDIDMethod(method string, parser DIDParser) {
+	didParsersMu.Lock()
+	defer didParsersMu.Unlock()
+	if _, ok := didParsers[method]; ok {
+		panic(fmt.Sprintf("DID method '%s' is already registered", method))
+	}
+	didParsers[method] = parser
+}
+
+// ParseDID parses a DID string with the parser registered for its method.
+func ParseDID(did string) (DID, error) {
+	method, err := didMethod(did)
+	if err != nil {
+		return nil, err
+	}
+	didParsersMu.RLock()
+	parser, ok := didParsers[method]
+	didParsersMu.RUnlock()
+	if !ok {
+		return nil, UnsupportedDIDMethodError{Method: method}
+	}
+	return parser(did)
+}
+
+// TokenIDFromDID returns the token ID of a DID string of any method that refers to a token.
+func TokenIDFromDID(did string) (uint32, error) {
+	parsed, err := ParseDID(did)
+	if err != nil {
+		return 0, err
+	}
+	tokenDID, ok := parsed.(TokenDID)
+	if !ok {
+		return 0, NotTokenDIDError{DID: did}
+	}
+	return tokenDID.GetTokenID(), nil
+}
+
+// didMethod returns the method of a did:<method>:<id> string.
+func didMethod(did string) (string, error) {
+	parts := strings.SplitN(did, ":", 3)
+	if len(parts) != 3 || parts[0] != "did" || parts[1] == "" {
+		return "", errInvalidDID
+	}
+	return parts[1], nil
+}
+
+// parseChainID parses a decimal or 0x prefixed hex chain ID.
+func parseChainID(chainID string) (uint64, error) {
+	if hex, ok := strings.CutPrefix(chainID, "0x"); ok {
+		return strconv.ParseUint(hex, 16, 64)
+	}
+	return strconv.ParseUint(chainID, 10, 64)
+}
+
+// ERC721DIDMethod is the method of ERC721DID strings.
+const ERC721DIDMethod = "erc721"
+
+// ERC721DID is a DID for an ERC-721 token, did:erc721:<chainID>:<contract>:<tokenID>.
+type ERC721DID struct {
+	ChainID         uint64         `json:"chainId"`
+	ContractAddress common.Address `json:"contract"`
+	TokenID         uint32         `json:"tokenId"`
+}
+
+// DecodeERC721DID decodes a did:erc721 string.
+func DecodeERC721DID(did string) (ERC721DID, error) {
+	// sample did "did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF:1"
+	parts := strings.Split(did, ":")
+	if len(parts) != 5 || parts[0] != "did" || parts[1] != ERC721DIDMethod {
+		return ERC721DID{}, errInvalidDID
+	}
+	chainID, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return ERC721DID{}, fmt.Errorf("invalid chainID: %w", err)
+	}
+	if !common.IsHexAddress(parts[3]) {
+		return ERC721DID{}, errors.New("invalid contract address")
+	}
+	tokenID, err := strconv.ParseUint(parts[4], 10, 32)
+	if err != nil {
+		return ERC721DID{}, fmt.Errorf("invalid tokenID: %w", err)
+	}
+	return ERC721DID{
+		ChainID:         chainID,
+		ContractAddress: common.HexToAddress(parts[3]),
+		TokenID:         uint32(tokenID),
+	}, nil
+}
+
+// Method returns "erc721".
+func (ERC721DID) Method() string {
+	return ERC721DIDMethod
+}
+
+// GetTokenID returns the token ID.
+func (d ERC721DID) GetTokenID() uint32 {
+	return d.TokenID
+}
+
+// String returns the string representation of the ERC721DID.
+func (d ERC721DID) String() string {
+	return fmt.Sprintf("did:%s:%d:%s:%d", ERC721DIDMethod, d.ChainID, d.ContractAddress.Hex(), d.TokenID)
+}
+
+// EthrDIDMethod is the method of EthrDID strings.
+const EthrDIDMethod = "ethr"
+
+// EthrDID is a DID for an Ethereum account, did:ethr:<address> or did:ethr:<chainID>:<address>.
+type EthrDID struct {
+	// ChainID is the chain of the account, or 0 if the DID does not name a chain.
+	ChainID uint64         `json:"chainId"`
+	Address common.Address `json:"address"`
+}
+
+// DecodeEthrDID decodes a did:ethr string. The chain ID may be decimal or 0x prefixed hex.
+func DecodeEthrDID(did string) (EthrDID, error) {
+	// sample dids "did:ethr:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF" and "did:ethr:0x89:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF"
+	parts := strings.Split(did, ":")
+	if len(parts) < 3 || len(parts) > 4 || parts[0] != "did" || parts[1] != EthrDIDMethod {
+		return EthrDID{}, errInvalidDID
+	}
+	var ethrDID EthrDID
+	if len(parts) == 4 {
+		chainID, err := parseChainID(parts[2])
+		if err != nil {
+			return EthrDID{}, fmt.Errorf("invalid chainID: %w", err)
+		}
+		ethrDID.ChainID = chainID
+	}
+	addr := parts[len(parts)-1]
+	if !common.IsHexAddress(addr) {
+		return EthrDID{}, errors.New("invalid address")
+	}
+	ethrDID.Address = common.HexToAddress(addr)
+	return ethrDID, nil
+}
+
+// Method returns "ethr".
+func (EthrDID) Method() string {
+	return EthrDIDMethod
+}
+
+// String returns the string representation of the EthrDID.
+func (d EthrDID) String() string {
+	if d.ChainID == 0 {
+		return fmt.Sprintf("did:%s:%s", EthrDIDMethod, d.Address.Hex())
+	}
+	return fmt.Sprintf("did:%s:%d:%s", EthrDIDMethod, d.ChainID, d.Address.Hex())
+}
+
+// VINDIDMethod is the method of VINDID strings.
+const VINDIDMethod = "vin"
+
+// vinLength is the length of a vehicle identification number.
+const vinLength = 17
+
+// VINDID is a DID for a vehicle identified by its VIN, did:vin:<VIN>.
+type VINDID struct {
+	VIN string `json:"vin"`
+}
+
+// DecodeVINDID decodes a did:vin string.
+// The VIN must be 17 letters or digits other than I, O and Q, and is upper cased.
+func DecodeVINDID(did string) (VINDID, error) {
+	// sample did "did:vin:1HGCM82633A004352"
+	parts := strings.Split(did, ":")
+	if len(parts) != 3 || parts[0] != "did" || parts[1] != VINDIDMethod {
+		return VINDID{}, errInvalidDID
+	}
+	vin := strings.ToUpper(parts[2])
+	if len(vin) != vinLength {
+		return VINDID{}, fmt.Errorf("invalid VIN: must be %d characters", vinLength)
+	}
+	for _, r := range vin {
+		if (r < '0' || r > '9') && (r < 'A' || r > 'Z') || r == 'I' || r == 'O' || r == 'Q' {
+			return VINDID{}, fmt.Errorf("invalid VIN: character '%c' is not allowed", r)
+		}
+	}
+	return VINDID{VIN: vin}, nil
+}
+
+// Method returns "vin".
+func (VINDID) Method() string {
+	return VINDIDMethod
+}
+
+// String returns the string representation of the VINDID.
+func (d VINDID) String() string {
+	return fmt.Sprintf("did:%s:%s", VINDIDMethod, d.VIN)
+}
@@ -0,0 +1,109 @@
+package cloudevent_test
+
+import (
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDID(t *testing.T) {
+	t.Parallel()
+	contract := common.HexToAddress("0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF")
+	tests := []struct {
+		name        string
+		input       string
+		expectedDID cloudevent.DID
+		expectedErr bool
+	}{
+		{
+			name:        "nft",
+			input:       "did:nft:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_123",
+			expectedDID: cloudevent.NFTDID{ChainID: 137, ContractAddress: contract, TokenID: 123},
+		},
+		{
+			name:        "erc721",
+			input:       "did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF:123",
+			expectedDID: cloudevent.ERC721DID{ChainID: 137, ContractAddress: contract, TokenID: 123},
+		},
+		{
+			name:        "ethr without chain",
+			input:       "did:ethr:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF",
+			expectedDID: cloudevent.EthrDID{Address: contract},
+		},
+		{
+			name:        "ethr with hex chain",
+			input:       "did:ethr:0x89:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF",
+			expectedDID: cloudevent.EthrDID{ChainID: 137, Address: contract},
+		},
+		{
+			name:        "vin",
+			input:       "did:vin:1hgcm82633a004352",
+			expectedDID: cloudevent.VINDID{VIN: "1HGCM82633A004352"},
+		},
+		{name: "vin with invalid character", input: "did:vin:1HGCM82633A00435O", expectedErr: true},
+		{name: "erc721 missing token", input: "did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF", expectedErr: true},
+		{name: "unknown method", input: "did:web:example.com", expectedErr: true},
+		{name: "not a DID", input: "0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF", expectedErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			did, err := cloudevent.ParseDID(tt.input)
+			if tt.expectedErr {
+				require.Error(t, err)
+				require.Nil(t, did)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedDID, did)
+
+			// the string form must parse back to the same DID.
+			reparsed, err := cloudevent.ParseDID(did.String())
+			require.NoError(t, err)
+			require.Equal(t, did, reparsed)
+		})
+	}
+}
+
+func TestTokenIDFromDID(t *testing.T) {
+	t.Parallel()
+	tokenID, err := cloudevent.TokenIDFromDID("did:nft:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_7")
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), tokenID)
+
+	tokenID, err = cloudevent.TokenIDFromDID("did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF:8")
+	require.NoError(t, err)
+	require.Equal(t, uint32(8), tokenID)
+
+	_, err = cloudevent.TokenIDFromDID("did:vin:1HGCM82633A004352")
+	var notTokenErr cloudevent.NotTokenDIDError
+	require.ErrorAs(t, err, &notTokenErr)
+
+	_, err = cloudevent.TokenIDFromDID("did:web:example.com")
+	var methodErr cloudevent.UnsupportedDIDMethodError
+	require.ErrorAs(t, err, &methodErr)
+	require.Equal(t, "web", methodErr.Method)
+}
+
+type testDID struct{ tokenID uint32 }
+
+func (testDID) Method() string       { return "test" }
+func (d testDID) String() string     { return "did:test:" }
+func (d testDID) GetTokenID() uint32 { return d.tokenID }
+
+func TestRegisterDIDMethod(t *testing.T) {
+	t.Parallel()
+	cloudevent.RegisterDIDMethod("test", func(string) (cloudevent.DID, error) {
+		return testDID{tokenID: 9}, nil
+	})
+	tokenID, err := cloudevent.TokenIDFromDID("did:test:anything")
+	require.NoError(t, err)
+	require.Equal(t, uint32(9), tokenID)
+
+	require.Panics(t, func() {
+		cloudevent.RegisterDIDMethod(cloudevent.NFTDIDMethod, nil)
+	})
+}
@@ -11,6 +11,9 @@ import (
 
 var errInvalidDID = errors.New("invalid DID")
 
+// NFTDIDMethod is the method of NFTDID strings.
+const NFTDIDMethod = "nft"
+
 // NFTDID is a Decentralized Identifier for NFTs.
 type NFTDID struct {
 	ChainID         uint64         `json:"chainId"`
@@ -52,6 +55,16 @@ func DecodeNFTDID(did string) (NFTDID, error) {
 	}, nil
 }
 
+// Method returns "nft".
+func (NFTDID) Method() string {
+	return NFTDIDMethod
+}
+
+// GetTokenID returns the token ID.
+func (d NFTDID) GetTokenID() uint32 {
+	return d.TokenID
+}
+
 // String returns the string representation of the NFTDID.
 func (d NFTDID) String() string {
 	return fmt.Sprintf("did:nft:%d:%s_%d", d.ChainID, d.ContractAddress.Hex(), d.TokenID)
@@ -0,0 +1,177 @@
+package cloudevent
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignatureExtension is the name of the extension attribute that holds the signature of the event data.
+const SignatureExtension = "signature"
+
+// signatureLength is the length of an Ethereum signature, r || s || v.
+const signatureLength = 65
+
+// errMissingSignature is returned when verifying an event without a signature extension.
+var errMissingSignature = errors.New("event has no signature")
+
+// SignerResolver returns the address that is expected to sign the events of a DID.
+type SignerResolver interface {
+	ResolveSigner(ctx context.Context, did string) (common.Address, error)
+}
+
+// UnknownSignerError is returned by a SignerResolver that has no signer for a DID.
+type UnknownSignerError struct {
+	DID string
+}
+
+func (e UnknownSignerError) Error() string {
+	return fmt.Sprintf("no signer for DID '%s'", e.DID)
+}
+
+// SignerMismatchError is returned when an event is signed by a different address than the one resolved for its producer.
+type SignerMismatchError struct {
+	Expected common.Address
+	Actual   common.Address
+}
+
+func (e SignerMismatchError) Error() string {
+	return fmt.Sprintf("event signed by %s but expected %s", e.Actual.Hex(), e.Expected.Hex())
+}
+
+// Verify checks that the signature extension of the event is a signature of the event data by the signer of its producer.
+// It returns the recovered signer address.
+// The signature is an EIP-191 personal signature of the data as it appears in the event.
+func Verify[A any](ctx context.Context, event CloudEvent[A], resolver SignerResolver) (common.Address, error) {
+	signature, ok := event.Extras[SignatureExtension].(string)
+	if !ok || signature == "" {
+		return common.Address{}, errMissingSignature
+	}
+	data, err := encodeData(event.Data)
+	if err != nil {
+		return common.Address{}, err
+	}
+	signer, err := RecoverSigner(data, signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	expected, err := resolver.ResolveSigner(ctx, event.Producer)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to resolve signer of producer: %w", err)
+	}
+	if signer != expected {
+		return signer, SignerMismatchError{Expected: expected, Actual: signer}
+	}
+	return signer, nil
+}
+
+// RecoverSigner returns the address that created the hex encoded EIP-191 personal signature of data.
+// The recovery ID of the signature may be 0, 1, 27 or 28.
+func RecoverSigner(data []byte, signature string) (common.Address, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if len(sig) != signatureLength {
+		return common.Address{}, fmt.Errorf("signature must be %d bytes but is %d", signatureLength, len(sig))
+	}
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash(data), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// LocalSignerResolver is an in-memory SignerResolver.
+type LocalSignerResolver struct {
+	mu      sync.RWMutex
+	signers map[string]common.Address
+}
+
+// NewLocalSignerResolver creates a LocalSignerResolver with the given signers keyed by DID.
+func NewLocalSignerResolver(signers map[string]common.Address) *LocalSignerResolver {
+	resolver := &LocalSignerResolver{signers: make(map[string]common.Address, len(signers))}
+	for did, addr := range signers {
+		resolver.signers[did] = addr
+	}
+	return resolver
+}
+
+// SetSigner sets the signer of a DID.
+func (r *LocalSignerResolver) SetSigner(did string, signer common.Address) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.signers[did] = signer
+}
+
+// ResolveSigner returns the signer of a DID or an UnknownSignerError.
+func (r *LocalSignerResolver) ResolveSigner(_ context.Context, did string) (common.Address, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	signer, ok := r.signers[did]
+	if !ok {
+		return common.Address{}, UnknownSignerError{DID: did}
+	}
+	return signer, nil
+}
+
+var _ SignerResolver = (*LocalSignerResolver)(nil)
+
+// Sign returns a copy of the event with the signature extension set to the EIP-191 personal signature of its data by key.
+// The data is canonicalized first: JSON in []byte or json.RawMessage data is compacted and other data is encoded as JSON,
+// so the signature still verifies after the event is marshaled.
+// The signature has a recovery ID of 27 or 28, as produced by devices.
+func Sign[A any](event CloudEvent[A], key *ecdsa.PrivateKey) (CloudEvent[A], error) {
+	data, err := canonicalData(&event.Data)
+	if err != nil {
+		return event, err
+	}
+	sig, err := crypto.Sign(accounts.TextHash(data), key)
+	if err != nil {
+		return event, fmt.Errorf("failed to sign data: %w", err)
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+
+	extras := make(map[string]any, len(event.Extras)+1)
+	for k, v := range event.Extras {
+		extras[k] = v
+	}
+	extras[SignatureExtension] = hexutil.Encode(sig)
+	event.Extras = extras
+	return event, nil
+}
+
+// canonicalData returns the bytes to sign for the data, compacting JSON held in raw data in place.
+func canonicalData(data any) ([]byte, error) {
+	var raw *[]byte
+	switch typed := data.(type) {
+	case *[]byte:
+		raw = typed
+	case *json.RawMessage:
+		raw = (*[]byte)(typed)
+	default:
+		return encodeData(reflect.ValueOf(data).Elem().Interface())
+	}
+	if !json.Valid(*raw) {
+		return *raw, nil
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, *raw); err != nil {
+		return nil, fmt.Errorf("failed to compact data: %w", err)
+	}
+	*raw = buf.Bytes()
+	return *raw, nil
+}
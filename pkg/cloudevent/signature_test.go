@@ -0,0 +1,206 @@
+package cloudevent_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPrivateKey = "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318"
+	testProducer   = "did:nft:137:0x9c94C395cBcBDe662235E0A9d3bB87Ad708561BA_42"
+)
+
+// personalSign signs data the way devices do, with a recovery ID of 27 or 28.
+func personalSign(t *testing.T, data []byte) string {
+	t.Helper()
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+	sig, err := crypto.Sign(accounts.TextHash(data), key)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27
+	return hexutil.Encode(sig)
+}
+
+func testSigner(t *testing.T) common.Address {
+	t.Helper()
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+	return crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	data := json.RawMessage(`{"signals":[{"name":"speed","value":10}]}`)
+	signer := testSigner(t)
+	resolver := cloudevent.NewLocalSignerResolver(map[string]common.Address{testProducer: signer})
+
+	event := cloudevent.CloudEvent[json.RawMessage]{
+		CloudEventHeader: cloudevent.CloudEventHeader{
+			Producer: testProducer,
+			Extras:   map[string]any{cloudevent.SignatureExtension: personalSign(t, data)},
+		},
+		Data: data,
+	}
+	recovered, err := cloudevent.Verify(ctx, event, resolver)
+	require.NoError(t, err)
+	require.Equal(t, signer, recovered)
+
+	t.Run("tampered data", func(t *testing.T) {
+		t.Parallel()
+		tampered := event
+		tampered.Data = json.RawMessage(`{"signals":[{"name":"speed","value":99}]}`)
+		_, err := cloudevent.Verify(ctx, tampered, resolver)
+		var mismatchErr cloudevent.SignerMismatchError
+		require.ErrorAs(t, err, &mismatchErr)
+		require.Equal(t, signer, mismatchErr.Expected)
+	})
+
+	t.Run("unknown producer", func(t *testing.T) {
+		t.Parallel()
+		unknown := event
+		unknown.Producer = "did:nft:137:0x9c94C395cBcBDe662235E0A9d3bB87Ad708561BA_43"
+		_, err := cloudevent.Verify(ctx, unknown, resolver)
+		var unknownErr cloudevent.UnknownSignerError
+		require.ErrorAs(t, err, &unknownErr)
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		t.Parallel()
+		unsigned := event
+		unsigned.Extras = nil
+		_, err := cloudevent.Verify(ctx, unsigned, resolver)
+		require.Error(t, err)
+	})
+
+	t.Run("malformed signature", func(t *testing.T) {
+		t.Parallel()
+		malformed := event
+		malformed.Extras = map[string]any{cloudevent.SignatureExtension: "0x1234"}
+		_, err := cloudevent.Verify(ctx, malformed, resolver)
+		require.Error(t, err)
+	})
+}
+
+// autoPiStatus is a status message signed by an AutoPi device, the same fixture as in pkg/autopi/status_test.go.
+const autoPiStatus = `{"data":{"device":{"serial":"60d4af69-86e8-b790-02d3-c0a9dc4d6c8a","softwareVersion":"v1.0.0"},"timestamp":1732224181876,"vehicle":{"make":"MINI","model":"Countryman","signals":[{"name":"batteryVoltage","timestamp":1732224181876,"value":12.95}],"year":2018}},"signature":"0x67bdfbfce03ef7c6577a4a64de037db97d882ef158ee6d1b3adc96e0e58599b2508bb74f8780e102e0c50b7b30385ed6160aa8218c9793cb00fc8f8b355a966c1b","time":"2024-11-21T21:23:01.876617869Z","type":"com.dimo.device.status.v2","vehicleTokenId":1, "deviceTokenId": 2222}`
+
+func TestVerifyAutoPiStatus(t *testing.T) {
+	t.Parallel()
+	const producer = "did:nft:2:0x325b45949C833986bC98e98a49F3CA5C5c4643B5_2222"
+	deviceAddr := common.HexToAddress("0x0a5dCD536d499a31450FC22D4efCeB6698483a6B")
+	resolver := cloudevent.NewLocalSignerResolver(map[string]common.Address{producer: deviceAddr})
+
+	var event cloudevent.CloudEvent[json.RawMessage]
+	require.NoError(t, json.Unmarshal([]byte(autoPiStatus), &event))
+	event.Producer = producer
+	recovered, err := cloudevent.Verify(context.Background(), event, resolver)
+	require.NoError(t, err)
+	require.Equal(t, deviceAddr, recovered)
+
+	// the signature covers the data bytes as sent, a changed value must not verify.
+	event.Data = json.RawMessage(strings.Replace(string(event.Data), "12.95", "12.96", 1))
+	_, err = cloudevent.Verify(context.Background(), event, resolver)
+	var mismatchErr cloudevent.SignerMismatchError
+	require.ErrorAs(t, err, &mismatchErr)
+}
+
+func TestRecoverSignerRecoveryID(t *testing.T) {
+	t.Parallel()
+	data := []byte("hello")
+	sig := hexutil.MustDecode(personalSign(t, data))
+	sig[crypto.RecoveryIDOffset] -= 27
+	signer, err := cloudevent.RecoverSigner(data, hexutil.Encode(sig))
+	require.NoError(t, err)
+	require.Equal(t, testSigner(t), signer)
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		sign         func() (string, any, error)
+		expectedData any
+		expectedSig  string
+	}{
+		{
+			// vector from the web3.js eth.accounts.sign documentation.
+			name: "raw bytes",
+			sign: func() (string, any, error) {
+				event, err := cloudevent.Sign(cloudevent.CloudEvent[[]byte]{Data: []byte("Some data")}, key)
+				return signatureOf(event.Extras), event.Data, err
+			},
+			expectedData: []byte("Some data"),
+			expectedSig:  "0xb91467e570a6466aa9e9876cbcd013baba02900b8979d43fe208a4a4f339f5fd6007e74cd82e037b800186422fc2da167c747ef045e5d18a5f5d4300f8e1a0291c",
+		},
+		{
+			name: "raw JSON is compacted",
+			sign: func() (string, any, error) {
+				event, err := cloudevent.Sign(cloudevent.CloudEvent[json.RawMessage]{Data: json.RawMessage(`{"speed": 10}`)}, key)
+				return signatureOf(event.Extras), event.Data, err
+			},
+			expectedData: json.RawMessage(`{"speed":10}`),
+			expectedSig:  "0x7133bcdede1052f18aa8bb99231fecefc092f5f46e6788905d6df8a15883ec62173170c5f4cec1d97a9ac5430cc70a2688635a42df06c024ab3e3c55777deea11b",
+		},
+		{
+			name: "typed data",
+			sign: func() (string, any, error) {
+				event, err := cloudevent.Sign(cloudevent.CloudEvent[TestData]{Data: TestData{Message: "hello", Count: 42}}, key)
+				return signatureOf(event.Extras), event.Data, err
+			},
+			expectedData: TestData{Message: "hello", Count: 42},
+			expectedSig:  "0xec96ce4327158bf39698be353f801030a46901da0c51cea25e1f5dc5bbd5cc022122d4220af1daf6a6f8b6b2a5ba99ca518b8b71f3d8f078d4e19429a8f7a6ae1b",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sig, data, err := tt.sign()
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSig, sig)
+			require.Equal(t, tt.expectedData, data)
+		})
+	}
+}
+
+func TestSignVerifiesAfterMarshal(t *testing.T) {
+	t.Parallel()
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	require.NoError(t, err)
+	resolver := cloudevent.NewLocalSignerResolver(map[string]common.Address{testProducer: testSigner(t)})
+
+	event := cloudevent.CloudEvent[json.RawMessage]{
+		CloudEventHeader: cloudevent.CloudEventHeader{Producer: testProducer, Extras: map[string]any{"other": "kept"}},
+		Data:             json.RawMessage("{\n  \"speed\": 10\n}"),
+	}
+	signed, err := cloudevent.Sign(event, key)
+	require.NoError(t, err)
+	require.Nil(t, event.Extras[cloudevent.SignatureExtension], "input event must not be modified")
+	require.Equal(t, "kept", signed.Extras["other"])
+
+	// receivers decode the structured event into raw data before verifying.
+	structured, err := json.Marshal(signed)
+	require.NoError(t, err)
+	var received cloudevent.CloudEvent[json.RawMessage]
+	require.NoError(t, json.Unmarshal(structured, &received))
+	_, err = cloudevent.Verify(context.Background(), received, resolver)
+	require.NoError(t, err)
+}
+
+func signatureOf(extras map[string]any) string {
+	sig, _ := extras[cloudevent.SignatureExtension].(string)
+	return sig
+}
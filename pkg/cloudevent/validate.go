@@ -0,0 +1,147 @@
+package cloudevent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// extensionNameRegex matches extension attribute names allowed by the CloudEvents spec.
+var extensionNameRegex = regexp.MustCompile(`^[a-z0-9]{1,20}$`)
+
+var (
+	eventTypesMu sync.RWMutex
+	eventTypes   = map[string]struct{}{
+		TypeStatus:              {},
+		TypeFingerprint:         {},
+		TypeVerifableCredential: {},
+		TypeUnknown:             {},
+	}
+)
+
+// RegisterEventType adds event types that pass Validate.
+func RegisterEventType(types ...string) {
+	eventTypesMu.Lock()
+	defer eventTypesMu.Unlock()
+	for _, eventType := range types {
+		eventTypes[eventType] = struct{}{}
+	}
+}
+
+func isRegisteredEventType(eventType string) bool {
+	eventTypesMu.RLock()
+	defer eventTypesMu.RUnlock()
+	_, ok := eventTypes[eventType]
+	return ok
+}
+
+// ValidationError is an invalid attribute of a CloudEvent.
+type ValidationError struct {
+	Attribute string
+	Reason    string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("attribute '%s' %s", e.Attribute, e.Reason)
+}
+
+// ValidationErrors are all the invalid attributes of a CloudEvent.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return "invalid CloudEvent: " + strings.Join(msgs, "; ")
+}
+
+// Validate checks the header against the CloudEvents 1.0 spec and DIMO conventions.
+// It returns ValidationErrors holding every invalid attribute, or nil if the header is valid.
+//   - id, source, type and specversion are required and specversion must be 1.0.
+//   - type must be registered, see RegisterEventType.
+//   - time, if set, must be representable in RFC 3339.
+//   - subject and producer, if set, must be DIDs that ParseDID understands.
+//   - extension names must be 1 to 20 lower case letters or digits.
+func (c *CloudEventHeader) Validate() error {
+	var errs ValidationErrors
+	required := []struct {
+		name  string
+		value string
+	}{
+		{name: "id", value: c.ID},
+		{name: "source", value: c.Source},
+		{name: "specversion", value: c.SpecVersion},
+		{name: "type", value: c.Type},
+	}
+	for _, attr := range required {
+		if attr.value == "" {
+			errs = append(errs, ValidationError{Attribute: attr.name, Reason: "is required"})
+		}
+	}
+	if c.SpecVersion != "" && c.SpecVersion != SpecVersion {
+		errs = append(errs, ValidationError{Attribute: "specversion", Reason: fmt.Sprintf("must be '%s'", SpecVersion)})
+	}
+	if c.Type != "" && !isRegisteredEventType(c.Type) {
+		errs = append(errs, ValidationError{Attribute: "type", Reason: fmt.Sprintf("'%s' is not a registered event type", c.Type)})
+	}
+	if !c.Time.IsZero() && (c.Time.Year() < 0 || c.Time.Year() > 9999) {
+		errs = append(errs, ValidationError{Attribute: "time", Reason: "must have a year between 0 and 9999 to be formatted as RFC 3339"})
+	}
+	for _, attr := range []struct {
+		name  string
+		value string
+	}{
+		{name: "subject", value: c.Subject},
+		{name: "producer", value: c.Producer},
+	} {
+		if attr.value == "" {
+			continue
+		}
+		if _, err := ParseDID(attr.value); err != nil {
+			errs = append(errs, ValidationError{Attribute: attr.name, Reason: fmt.Sprintf("is not a valid DID: %v", err)})
+		}
+	}
+	for name := range c.Extras {
+		if !extensionNameRegex.MatchString(name) {
+			errs = append(errs, ValidationError{Attribute: name, Reason: "must be 1 to 20 lower case letters or digits"})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// ValidateJSON validates the header of a structured mode CloudEvent like Validate.
+// Decoding defaults a missing specversion to SpecVersion, so the raw JSON is also checked for the attribute.
+func ValidateJSON(data []byte) error {
+	var hdr CloudEventHeader
+	if err := json.Unmarshal(data, &hdr); err != nil {
+		return fmt.Errorf("failed to unmarshal CloudEvent header: %w", err)
+	}
+	var raw struct {
+		SpecVersion *string `json:"specversion"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal CloudEvent header: %w", err)
+	}
+	var errs ValidationErrors
+	if raw.SpecVersion == nil || *raw.SpecVersion == "" {
+		errs = append(errs, ValidationError{Attribute: "specversion", Reason: "is required"})
+	}
+	if err := hdr.Validate(); err != nil {
+		var hdrErrs ValidationErrors
+		if !errors.As(err, &hdrErrs) {
+			return err
+		}
+		errs = append(errs, hdrErrs...)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
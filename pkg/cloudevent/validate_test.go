@@ -0,0 +1,169 @@
+package cloudevent_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/stretchr/testify/require"
+)
+
+func validHeader() cloudevent.CloudEventHeader {
+	return cloudevent.CloudEventHeader{
+		ID:          "2pcYwspbaBFJ7NPGZ2kivkuJ12a",
+		Source:      "0xF26421509Efe92861a587482100c6d728aBf1CD0",
+		Producer:    "did:nft:137:0x9c94C395cBcBDe662235E0A9d3bB87Ad708561BA_42",
+		Subject:     "did:nft:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_123",
+		SpecVersion: cloudevent.SpecVersion,
+		Time:        time.Date(2024, 11, 1, 12, 0, 0, 0, time.UTC),
+		Type:        cloudevent.TypeStatus,
+		Extras:      map[string]any{"signature": "0x00"},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		modify         func(hdr *cloudevent.CloudEventHeader)
+		expectedErrors []string
+	}{
+		{name: "valid", modify: func(*cloudevent.CloudEventHeader) {}},
+		{
+			name: "optional attributes unset",
+			modify: func(hdr *cloudevent.CloudEventHeader) {
+				hdr.Producer = ""
+				hdr.Subject = ""
+				hdr.Time = time.Time{}
+				hdr.Extras = nil
+			},
+		},
+		{
+			name: "missing required attributes",
+			modify: func(hdr *cloudevent.CloudEventHeader) {
+				hdr.ID = ""
+				hdr.Source = ""
+				hdr.SpecVersion = ""
+				hdr.Type = ""
+			},
+			expectedErrors: []string{"id", "source", "specversion", "type"},
+		},
+		{
+			name:           "wrong spec version",
+			modify:         func(hdr *cloudevent.CloudEventHeader) { hdr.SpecVersion = "0.3" },
+			expectedErrors: []string{"specversion"},
+		},
+		{
+			name:           "unregistered type",
+			modify:         func(hdr *cloudevent.CloudEventHeader) { hdr.Type = "dimo.unregistered" },
+			expectedErrors: []string{"type"},
+		},
+		{
+			name:           "time out of range",
+			modify:         func(hdr *cloudevent.CloudEventHeader) { hdr.Time = time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC) },
+			expectedErrors: []string{"time"},
+		},
+		{
+			name: "invalid DIDs",
+			modify: func(hdr *cloudevent.CloudEventHeader) {
+				hdr.Subject = "did:web:example.com"
+				hdr.Producer = "0xF26421509Efe92861a587482100c6d728aBf1CD0"
+			},
+			expectedErrors: []string{"subject", "producer"},
+		},
+		{
+			name: "invalid extension names",
+			modify: func(hdr *cloudevent.CloudEventHeader) {
+				hdr.Extras = map[string]any{"Signature": "0x00", "averyveryverylongextension": true}
+			},
+			expectedErrors: []string{"Signature", "averyveryverylongextension"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdr := validHeader()
+			tt.modify(&hdr)
+			err := hdr.Validate()
+			if len(tt.expectedErrors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var validationErrs cloudevent.ValidationErrors
+			require.ErrorAs(t, err, &validationErrs)
+			attributes := make([]string, len(validationErrs))
+			for i, validationErr := range validationErrs {
+				attributes[i] = validationErr.Attribute
+			}
+			require.ElementsMatch(t, tt.expectedErrors, attributes)
+		})
+	}
+}
+
+func TestValidateDecodedSpecVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		json        string
+		specVersion string
+	}{
+		{
+			name:        "unsupported version",
+			json:        `{"id":"1","source":"test-source","specversion":"0.3","type":"dimo.status"}`,
+			specVersion: "0.3",
+		},
+		{
+			name:        "missing version",
+			json:        `{"id":"1","source":"test-source","type":"dimo.status"}`,
+			specVersion: cloudevent.SpecVersion,
+		},
+		{
+			name:        "empty version",
+			json:        `{"id":"1","source":"test-source","specversion":"","type":"dimo.status"}`,
+			specVersion: cloudevent.SpecVersion,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var hdr cloudevent.CloudEventHeader
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &hdr))
+			require.Equal(t, tt.specVersion, hdr.SpecVersion)
+			var validationErrs cloudevent.ValidationErrors
+			require.ErrorAs(t, cloudevent.ValidateJSON([]byte(tt.json)), &validationErrs)
+			require.Len(t, validationErrs, 1)
+			require.Equal(t, "specversion", validationErrs[0].Attribute)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+		require.NoError(t, cloudevent.ValidateJSON([]byte(`{"id":"1","source":"test-source","specversion":"1.0","type":"dimo.status"}`)))
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		t.Parallel()
+		header := http.Header{}
+		header.Set("Ce-Specversion", "0.3")
+		header.Set("Ce-Id", "1")
+		header.Set("Ce-Source", "test-source")
+		header.Set("Ce-Type", cloudevent.TypeStatus)
+		event, err := cloudevent.DecodeHTTP[[]byte](header, nil)
+		require.NoError(t, err)
+		require.Equal(t, "0.3", event.SpecVersion)
+		require.Error(t, event.Validate())
+	})
+}
+
+func TestRegisterEventType(t *testing.T) {
+	t.Parallel()
+	hdr := validHeader()
+	hdr.Type = "dimo.test.registered"
+	require.Error(t, hdr.Validate())
+	cloudevent.RegisterEventType(hdr.Type)
+	require.NoError(t, hdr.Validate())
+}
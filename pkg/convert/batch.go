@@ -0,0 +1,45 @@
+package convert
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+)
+
+// EventSignals are the signals decoded from a single event of a batch.
+type EventSignals struct {
+	// Index is the position of the event in the batch.
+	Index   int
+	Signals []vss.Signal
+	// Err is the error returned while decoding the event, Signals may still hold the signals that were decoded.
+	Err error
+}
+
+// DecodeBatch reads a batch of CloudEvents from r and decodes the signals of each event with decode.
+// Errors for a single event are returned in its EventSignals.
+// An error is only returned if the batch is malformed, along with the events read before the error.
+func DecodeBatch(r io.Reader, decode func([]byte) ([]vss.Signal, error)) ([]EventSignals, error) {
+	dec := cloudevent.NewBatchDecoder[json.RawMessage](r)
+	var results []EventSignals
+	for i := 0; ; i++ {
+		raw, err := dec.NextRaw()
+		if errors.Is(err, io.EOF) {
+			return results, nil
+		}
+		if err != nil {
+			return results, err
+		}
+		result := EventSignals{Index: i}
+		result.Signals, result.Err = decode(raw)
+		if result.Err != nil && len(result.Signals) == 0 {
+			var convErr ConversionError
+			if errors.As(result.Err, &convErr) {
+				result.Signals = convErr.DecodedSignals
+			}
+		}
+		results = append(results, result)
+	}
+}
@@ -4,12 +4,17 @@ package convert
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/DIMO-Network/model-garage/pkg/vss"
 )
 
+// ErrNotFound is returned by conversion functions when the original data does not hold a value for the signal.
+// For example, when a raw value is one of the declared error values.
+var ErrNotFound = errors.New("field not found")
+
 // VersionError is an error for unsupported specversion.
 type VersionError struct {
 	Version string
@@ -66,6 +71,73 @@ func (e ConversionError) Unwrap() []error {
 	return e.Errors
 }
 
+// NotAllowedError is returned when a converted value is not one of the values allowed by the VSS specification.
+type NotAllowedError struct {
+	Value   string
+	Allowed []string
+}
+
+// Error returns the error message.
+func (e NotAllowedError) Error() string {
+	return fmt.Sprintf("value '%s' is not one of the allowed values %v", e.Value, e.Allowed)
+}
+
+// CheckAllowed returns a NotAllowedError if val is not one of the allowed values.
+func CheckAllowed(val string, allowed ...string) error {
+	if slices.Contains(allowed, val) {
+		return nil
+	}
+	return NotAllowedError{Value: val, Allowed: allowed}
+}
+
+// OutOfRangeError is returned when a converted value is outside the range defined by the VSS specification.
+type OutOfRangeError struct {
+	// Raw is the original value read from the payload before any conversion.
+	Raw any
+	// Value is the value after conversion to the unit of the signal, which is the value compared to Min and Max.
+	Value float64
+	Min   float64
+	Max   float64
+}
+
+// Error returns the error message.
+func (e OutOfRangeError) Error() string {
+	return fmt.Sprintf("value %v (raw %v) is outside the allowed range [%v, %v]", e.Value, e.Raw, e.Min, e.Max)
+}
+
+// CheckRange returns an OutOfRangeError if val is less than minVal or greater than maxVal.
+// raw is the original value that val was converted from, it is kept in the error.
+// Use math.Inf for an unbounded side of the range.
+func CheckRange(raw any, val, minVal, maxVal float64) error {
+	if val < minVal || val > maxVal {
+		return OutOfRangeError{Raw: raw, Value: val, Min: minVal, Max: maxVal}
+	}
+	return nil
+}
+
+// ClampRange limits val to the range [minVal, maxVal].
+func ClampRange(val, minVal, maxVal float64) float64 {
+	return min(max(val, minVal), maxVal)
+}
+
+// ApplyRange applies a range policy of "error", "drop" or "clamp" to a converted value.
+// With "clamp" the value is limited to the range, with "drop" an out-of-range value returns an error wrapping ErrNotFound,
+// and with "error" it returns an OutOfRangeError.
+// raw is the original value that val was converted from, it is kept in the error.
+func ApplyRange(policy string, raw any, val, minVal, maxVal float64) (float64, error) {
+	switch policy {
+	case "clamp":
+		return ClampRange(val, minVal, maxVal), nil
+	case "drop":
+		if err := CheckRange(raw, val, minVal, maxVal); err != nil {
+			return val, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return val, nil
+	default:
+		return val, CheckRange(raw, val, minVal, maxVal)
+	}
+}
+
 var errInvalidType = errors.New("invalid type")
 
 // InvalidTypeError is returned when a field is not of the expected type or not found.
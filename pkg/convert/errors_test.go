@@ -0,0 +1,61 @@
+package convert_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckAllowed(t *testing.T) {
+	t.Parallel()
+	allowed := []string{"COMBUSTION", "HYBRID", "ELECTRIC"}
+	require.NoError(t, convert.CheckAllowed("HYBRID", allowed...))
+
+	err := convert.CheckAllowed("STEAM", allowed...)
+	var notAllowedErr convert.NotAllowedError
+	require.ErrorAs(t, err, &notAllowedErr)
+	require.Equal(t, "STEAM", notAllowedErr.Value)
+	require.Equal(t, allowed, notAllowedErr.Allowed)
+}
+
+func TestCheckRange(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, convert.CheckRange(45.0, 45, -90, 90))
+	require.NoError(t, convert.CheckRange(-90.0, -90, -90, 90))
+	require.NoError(t, convert.CheckRange(1e9, 1e9, 0, math.Inf(1)))
+
+	// The raw value is kept next to the value after unit conversion.
+	err := convert.CheckRange("FFFF", 6553.5, 0, 1000)
+	var rangeErr convert.OutOfRangeError
+	require.ErrorAs(t, err, &rangeErr)
+	require.Equal(t, convert.OutOfRangeError{Raw: "FFFF", Value: 6553.5, Min: 0, Max: 1000}, rangeErr)
+	require.Error(t, convert.CheckRange(-1.0, -1, 0, math.Inf(1)))
+}
+
+func TestClampRange(t *testing.T) {
+	t.Parallel()
+	require.InDelta(t, 90.0, convert.ClampRange(900, -90, 90), 0)
+	require.InDelta(t, -90.0, convert.ClampRange(-900, -90, 90), 0)
+	require.InDelta(t, 12.5, convert.ClampRange(12.5, 0, 100), 0)
+}
+
+func TestApplyRange(t *testing.T) {
+	t.Parallel()
+	val, err := convert.ApplyRange("clamp", 900.0, 900, -90, 90)
+	require.NoError(t, err)
+	require.InDelta(t, 90.0, val, 0)
+
+	_, err = convert.ApplyRange("drop", 900.0, 900, -90, 90)
+	require.ErrorIs(t, err, convert.ErrNotFound)
+
+	_, err = convert.ApplyRange("error", 900.0, 900, -90, 90)
+	var rangeErr convert.OutOfRangeError
+	require.ErrorAs(t, err, &rangeErr)
+	require.NotErrorIs(t, err, convert.ErrNotFound)
+
+	val, err = convert.ApplyRange("error", 45.0, 45, -90, 90)
+	require.NoError(t, err)
+	require.InDelta(t, 45.0, val, 0)
+}
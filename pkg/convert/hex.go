@@ -0,0 +1,41 @@
+package convert
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// HexUint parses a hex encoded unsigned integer of byteSize bytes.
+// The maximum value for the byte size means the value is not available,
+// so ErrNotFound is returned for it and for values that do not fit in byteSize bytes.
+func HexUint(val string, byteSize int) (uint64, error) {
+	rawInt, err := strconv.ParseUint(val, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse uint: %w", err)
+	}
+	if rawInt >= maxHexValue(byteSize) {
+		return 0, ErrNotFound
+	}
+	return rawInt, nil
+}
+
+// HexInt parses a hex encoded two's complement signed integer of byteSize bytes.
+// ErrNotFound is returned for values that do not fit in byteSize bytes.
+func HexInt(val string, byteSize int) (int64, error) {
+	rawUint, err := strconv.ParseUint(val, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse int: %w", err)
+	}
+	if rawUint > maxHexValue(byteSize) {
+		return 0, ErrNotFound
+	}
+	// Sign extend the two's complement value to 64 bits.
+	shift := 64 - 8*byteSize
+	return int64(rawUint<<shift) >> shift, nil
+}
+
+// maxHexValue returns the largest unsigned value of byteSize bytes.
+func maxHexValue(byteSize int) uint64 {
+	return math.MaxUint64 >> (64 - 8*byteSize)
+}
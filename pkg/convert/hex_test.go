@@ -0,0 +1,71 @@
+package convert_test
+
+import (
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHexUint(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		val      string
+		byteSize int
+		expected uint64
+		notFound bool
+	}{
+		{name: "value", val: "7B", byteSize: 1, expected: 123},
+		{name: "maximum means not available", val: "FF", byteSize: 1, notFound: true},
+		{name: "too large for byte size", val: "1FF", byteSize: 1, notFound: true},
+		{name: "two bytes", val: "FFFE", byteSize: 2, expected: 65534},
+		{name: "eight bytes", val: "FFFFFFFFFFFFFFFE", byteSize: 8, expected: 1<<64 - 2},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			val, err := convert.HexUint(tt.val, tt.byteSize)
+			if tt.notFound {
+				require.ErrorIs(t, err, convert.ErrNotFound)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+	_, err := convert.HexUint("zz", 1)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, convert.ErrNotFound)
+}
+
+func TestHexInt(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		val      string
+		byteSize int
+		expected int64
+		notFound bool
+	}{
+		{name: "positive", val: "1E", byteSize: 1, expected: 30},
+		{name: "negative", val: "EC", byteSize: 1, expected: -20},
+		{name: "minus one", val: "FF", byteSize: 1, expected: -1},
+		{name: "two bytes", val: "FFEC", byteSize: 2, expected: -20},
+		{name: "too large for byte size", val: "1EC", byteSize: 1, notFound: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			val, err := convert.HexInt(tt.val, tt.byteSize)
+			if tt.notFound {
+				require.ErrorIs(t, err, convert.ErrNotFound)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"runtime"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	_, filename, _, _ := runtime.Caller(0)
+	registerFunc := func() { goose.AddNamedMigrationContext(filename, upSignalTypedValues, downSignalTypedValues) }
+	registerFuncs = append(registerFuncs, registerFunc)
+}
+
+func upSignalTypedValues(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	upStatements := []string{
+		"ALTER TABLE signal ADD COLUMN IF NOT EXISTS value_bool Bool COMMENT 'bool value of the signal collected.'",
+		"ALTER TABLE signal ADD COLUMN IF NOT EXISTS value_int Int64 COMMENT 'int64 value of the signal collected.'",
+		"ALTER TABLE signal ADD COLUMN IF NOT EXISTS value_string_array Array(String) COMMENT 'string array value of the signal collected.'",
+	}
+	for _, upStatement := range upStatements {
+		_, err := tx.ExecContext(ctx, upStatement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downSignalTypedValues(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	downStatements := []string{
+		"ALTER TABLE signal DROP COLUMN IF EXISTS value_string_array",
+		"ALTER TABLE signal DROP COLUMN IF EXISTS value_int",
+		"ALTER TABLE signal DROP COLUMN IF EXISTS value_bool",
+	}
+	for _, downStatement := range downStatements {
+		_, err := tx.ExecContext(ctx, downStatement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"runtime"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	_, filename, _, _ := runtime.Caller(0)
+	registerFunc := func() { goose.AddNamedMigrationContext(filename, upSignalLatest, downSignalLatest) }
+	registerFuncs = append(registerFuncs, registerFunc)
+}
+
+func upSignalLatest(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	upStatements := []string{
+		createSignalLatestStmt,
+		createSignalLatestMVStmt,
+	}
+	for _, upStatement := range upStatements {
+		_, err := tx.ExecContext(ctx, upStatement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downSignalLatest(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	downStatements := []string{
+		"DROP VIEW IF EXISTS signal_latest_mv",
+		"DROP TABLE IF EXISTS signal_latest",
+	}
+	for _, downStatement := range downStatements {
+		_, err := tx.ExecContext(ctx, downStatement)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const createSignalLatestStmt = `
+CREATE TABLE IF NOT EXISTS signal_latest
+(
+	token_id UInt32 COMMENT 'token_id of this device data.',
+	name LowCardinality(String) COMMENT 'name of the signal collected.',
+	timestamp SimpleAggregateFunction(max, DateTime64(6, 'UTC')) COMMENT 'timestamp of the latest value of the signal.',
+	source AggregateFunction(argMax, String, DateTime64(6, 'UTC')) COMMENT 'source of the latest value of the signal.',
+	value_number AggregateFunction(argMax, Float64, DateTime64(6, 'UTC')) COMMENT 'latest float64 value of the signal.',
+	value_string AggregateFunction(argMax, String, DateTime64(6, 'UTC')) COMMENT 'latest string value of the signal.',
+	value_bool AggregateFunction(argMax, Bool, DateTime64(6, 'UTC')) COMMENT 'latest bool value of the signal.',
+	value_int AggregateFunction(argMax, Int64, DateTime64(6, 'UTC')) COMMENT 'latest int64 value of the signal.',
+	value_string_array AggregateFunction(argMax, Array(String), DateTime64(6, 'UTC')) COMMENT 'latest string array value of the signal.'
+)
+ENGINE = AggregatingMergeTree
+ORDER BY (token_id, name)
+`
+
+// signalLatestColumns aggregates signal rows into signal_latest rows.
+// The timestamp column is qualified since the max(timestamp) alias shadows it in the select list.
+const signalLatestColumns = `
+SELECT
+	token_id,
+	name,
+	max(signal.timestamp) AS timestamp,
+	argMaxState(source, signal.timestamp) AS source,
+	argMaxState(value_number, signal.timestamp) AS value_number,
+	argMaxState(value_string, signal.timestamp) AS value_string,
+	argMaxState(value_bool, signal.timestamp) AS value_bool,
+	argMaxState(value_int, signal.timestamp) AS value_int,
+	argMaxState(value_string_array, signal.timestamp) AS value_string_array
+FROM signal
+`
+
+const createSignalLatestMVStmt = `
+CREATE MATERIALIZED VIEW IF NOT EXISTS signal_latest_mv TO signal_latest AS` + signalLatestColumns + `GROUP BY token_id, name
+`
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+// backfillSignalLatestStmt copies the signal rows of a token_id range into signal_latest.
+const backfillSignalLatestStmt = `
+INSERT INTO signal_latest` + signalLatestColumns + `WHERE token_id >= ? AND token_id < ?
+GROUP BY token_id, name
+`
+
+// BackfillSignalLatest copies the signal rows inserted before the signal_latest materialized view existed into signal_latest.
+// It is not part of the migrations since it reads the whole signal table, and should be run once after the migrations are applied.
+// The rows are copied in ranges of batchSize token ids so that each insert stays small.
+// Rows also written by the materialized view are harmless since the argMax states merge to the same value.
+func BackfillSignalLatest(ctx context.Context, db *sql.DB, batchSize uint32) error {
+	if batchSize == 0 {
+		return errors.New("batch size must be greater than zero")
+	}
+	var maxTokenID uint32
+	if err := db.QueryRowContext(ctx, "SELECT max(token_id) FROM signal").Scan(&maxTokenID); err != nil {
+		return fmt.Errorf("failed to get max token id: %w", err)
+	}
+	for start := uint64(0); start <= uint64(maxTokenID); start += uint64(batchSize) {
+		end := start + uint64(batchSize)
+		if _, err := db.ExecContext(ctx, backfillSignalLatestStmt, start, end); err != nil {
+			return fmt.Errorf("failed to backfill token ids [%d, %d): %w", start, end, err)
+		}
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package migrations_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DIMO-Network/clickhouse-infra/pkg/connect"
 	"github.com/DIMO-Network/clickhouse-infra/pkg/connect/config"
@@ -40,11 +41,40 @@ func TestMigration(t *testing.T) {
 		{Name: vss.SourceCol, Type: "String", Comment: "source of the signal collected."},
 		{Name: vss.ValueNumberCol, Type: "Float64", Comment: "float64 value of the signal collected."},
 		{Name: vss.ValueStringCol, Type: "String", Comment: "string value of the signal collected."},
+		{Name: vss.ValueBoolCol, Type: "Bool", Comment: "bool value of the signal collected."},
+		{Name: vss.ValueIntCol, Type: "Int64", Comment: "int64 value of the signal collected."},
+		{Name: vss.ValueStringArrayCol, Type: "Array(String)", Comment: "string array value of the signal collected."},
 	}
 
 	// Check if the actual columns match the expected columns
 	require.Equal(t, expectedColumns, columns, "Unexpected table columns")
 
+	// Insert signals and check that the latest value of each is kept in the latest table
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	signals := []vss.Signal{
+		{TokenID: 1, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 10, Source: "0xA"},
+		{TokenID: 1, Timestamp: ts.Add(time.Minute), Name: vss.FieldSpeed, ValueNumber: 20, Source: "0xB"},
+		{TokenID: 1, Timestamp: ts, Name: vss.FieldPowertrainType, ValueString: "ELECTRIC", Source: "0xA"},
+		{TokenID: 2, Timestamp: ts.Add(time.Hour), Name: vss.FieldSpeed, ValueNumber: 90, Source: "0xA"},
+	}
+	batch, err := conn.PrepareBatch(ctx, "INSERT INTO "+vss.TableName)
+	require.NoError(t, err, "Failed to prepare batch")
+	for _, sig := range signals {
+		require.NoError(t, batch.Append(vss.SignalToSlice(sig)...), "Failed to append signal")
+	}
+	require.NoError(t, batch.Send(), "Failed to send batch")
+
+	err = migrations.BackfillSignalLatest(ctx, db, 1)
+	require.NoError(t, err, "Failed to backfill latest signals")
+
+	latest, err := vss.GetLatestSignals(ctx, conn, 1)
+	require.NoError(t, err, "Failed to get latest signals")
+	require.Len(t, latest, 2)
+	require.Equal(t, 20.0, latest[vss.FieldSpeed].ValueNumber)
+	require.Equal(t, "0xB", latest[vss.FieldSpeed].Source)
+	require.Equal(t, ts.Add(time.Minute), latest[vss.FieldSpeed].Timestamp.UTC())
+	require.Equal(t, "ELECTRIC", latest[vss.FieldPowertrainType].ValueString)
+
 	// Close the DB connection
 	err = db.Close()
 	assert.NoError(t, err, "Failed to close DB connection")
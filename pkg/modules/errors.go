@@ -0,0 +1,35 @@
+package modules
+
+import "fmt"
+
+// UnknownSourceError is returned when no module is registered for a CloudEvent's source or data version.
+type UnknownSourceError struct {
+	Source      string
+	DataVersion string
+}
+
+// Error returns the error message.
+func (e UnknownSourceError) Error() string {
+	return fmt.Sprintf("no module registered for source '%s' or data version '%s'", e.Source, e.DataVersion)
+}
+
+// UnknownModuleError is returned when a module name has not been registered.
+type UnknownModuleError struct {
+	Name string
+}
+
+// Error returns the error message.
+func (e UnknownModuleError) Error() string {
+	return fmt.Sprintf("unknown module '%s'", e.Name)
+}
+
+// UnsupportedError is returned when a module does not support an operation or event type.
+type UnsupportedError struct {
+	Module    string
+	Operation string
+}
+
+// Error returns the error message.
+func (e UnsupportedError) Error() string {
+	return fmt.Sprintf("module '%s' does not support operation '%s'", e.Module, e.Operation)
+}
@@ -0,0 +1,94 @@
+// Package modules provides a registry of source modules that decode raw CloudEvents into signals, fingerprints and events.
+// Source packages register themselves on import, so a caller only needs to blank import the sources it wants to handle.
+package modules
+
+import (
+	"context"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+)
+
+// TypeEvent is the CloudEvent type of discrete vehicle events, which Decode converts with Module.EventConvert.
+const TypeEvent = "dimo.event"
+
+func init() {
+	// events are decoded by modules, so the type is registered here rather than in cloudevent.
+	cloudevent.RegisterEventType(TypeEvent)
+}
+
+const (
+	// OperationSignals is the name of the signal conversion operation.
+	OperationSignals = "signals"
+	// OperationFingerprint is the name of the fingerprint conversion operation.
+	OperationFingerprint = "fingerprint"
+	// OperationEvents is the name of the event conversion operation.
+	OperationEvents = "events"
+	// OperationCloudEvent is the name of the CloudEvent conversion operation.
+	OperationCloudEvent = "cloudevent"
+)
+
+// Module decodes the messages of a single data source.
+// Operations a module does not support return an UnsupportedError.
+type Module interface {
+	// SignalConvert converts a raw CloudEvent into a slice of signals.
+	SignalConvert(ctx context.Context, msgData []byte) ([]vss.Signal, error)
+	// FingerprintConvert converts a raw CloudEvent into a fingerprint.
+	FingerprintConvert(ctx context.Context, msgData []byte) (cloudevent.Fingerprint, error)
+	// EventConvert converts a raw CloudEvent into a slice of events.
+	EventConvert(ctx context.Context, msgData []byte) ([]vss.Event, error)
+	// CloudEventConvert converts a raw device message into one or more marshaled CloudEvents.
+	CloudEventConvert(ctx context.Context, msgData []byte) ([][]byte, error)
+}
+
+// ConfigurableModule is a Module that requires source specific configuration.
+type ConfigurableModule interface {
+	Module
+	// SetConfig sets the module configuration from the given JSON string.
+	SetConfig(config string) error
+}
+
+// DecodedMessage is the result of decoding a CloudEvent with a registered module.
+type DecodedMessage struct {
+	// Header is the header of the decoded CloudEvent.
+	Header cloudevent.CloudEventHeader
+	// Module is the name of the module that decoded the message.
+	Module string
+	// Signals is set for status events.
+	Signals []vss.Signal
+	// Fingerprint is set for fingerprint events.
+	Fingerprint *cloudevent.Fingerprint
+	// Events is set for vehicle events.
+	Events []vss.Event
+}
+
+// defaultRegistry is the registry used by the package level functions.
+var defaultRegistry = NewRegistry()
+
+// Register adds a module to the default registry under the given name and data versions.
+// Register panics if the name or a data version is already registered.
+func Register(name string, module Module, dataVersions ...string) {
+	if err := defaultRegistry.Register(name, module, dataVersions...); err != nil {
+		panic(err)
+	}
+}
+
+// RegisterSource routes CloudEvents with the given source to the named module in the default registry.
+func RegisterSource(source, name string) error {
+	return defaultRegistry.RegisterSource(source, name)
+}
+
+// SetModuleConfig sets the configuration of the named module in the default registry.
+func SetModuleConfig(name, config string) error {
+	return defaultRegistry.SetModuleConfig(name, config)
+}
+
+// Lookup returns the module from the default registry that handles the given source and data version.
+func Lookup(source, dataVersion string) (string, Module, error) {
+	return defaultRegistry.Lookup(source, dataVersion)
+}
+
+// Decode decodes a raw CloudEvent using the default registry.
+func Decode(ctx context.Context, msgData []byte) (*DecodedMessage, error) {
+	return defaultRegistry.Decode(ctx, msgData)
+}
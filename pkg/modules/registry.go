@@ -0,0 +1,135 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+)
+
+// Registry holds named modules and routes CloudEvents to them by source or data version.
+type Registry struct {
+	mu           sync.RWMutex
+	modules      map[string]Module
+	sources      map[string]string
+	dataVersions map[string]string
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		modules:      map[string]Module{},
+		sources:      map[string]string{},
+		dataVersions: map[string]string{},
+	}
+}
+
+// Register adds a module under the given name.
+// CloudEvents with any of the given data versions are routed to the module unless their source is registered to another module.
+func (r *Registry) Register(name string, module Module, dataVersions ...string) error {
+	if module == nil {
+		return fmt.Errorf("module '%s' is nil", name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.modules[name]; ok {
+		return fmt.Errorf("module '%s' is already registered", name)
+	}
+	for _, dataVersion := range dataVersions {
+		if owner, ok := r.dataVersions[dataVersion]; ok {
+			return fmt.Errorf("data version '%s' is already registered to module '%s'", dataVersion, owner)
+		}
+	}
+	r.modules[name] = module
+	for _, dataVersion := range dataVersions {
+		r.dataVersions[dataVersion] = name
+	}
+	return nil
+}
+
+// RegisterSource routes CloudEvents with the given source to the named module.
+// Registering a source again replaces the previous route.
+func (r *Registry) RegisterSource(source, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.modules[name]; !ok {
+		return UnknownModuleError{Name: name}
+	}
+	r.sources[source] = name
+	return nil
+}
+
+// SetModuleConfig sets the configuration of the named module.
+func (r *Registry) SetModuleConfig(name, config string) error {
+	r.mu.RLock()
+	module, ok := r.modules[name]
+	r.mu.RUnlock()
+	if !ok {
+		return UnknownModuleError{Name: name}
+	}
+	configurable, ok := module.(ConfigurableModule)
+	if !ok {
+		return fmt.Errorf("module '%s' does not accept configuration", name)
+	}
+	if err := configurable.SetConfig(config); err != nil {
+		return fmt.Errorf("failed to configure module '%s': %w", name, err)
+	}
+	return nil
+}
+
+// Lookup returns the name and module that handles the given source and data version.
+// A registered source takes precedence over a registered data version.
+func (r *Registry) Lookup(source, dataVersion string) (string, Module, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	name, ok := r.sources[source]
+	if !ok {
+		name, ok = r.dataVersions[dataVersion]
+	}
+	if !ok {
+		return "", nil, UnknownSourceError{Source: source, DataVersion: dataVersion}
+	}
+	return name, r.modules[name], nil
+}
+
+// Decode decodes a raw CloudEvent with the module registered for its source or data version.
+// The CloudEvent type selects the conversion: fingerprint and event types are decoded as such and all other types as status signals.
+func (r *Registry) Decode(ctx context.Context, msgData []byte) (*DecodedMessage, error) {
+	var hdr cloudevent.CloudEventHeader
+	if err := json.Unmarshal(msgData, &hdr); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal CloudEvent header: %w", err)
+	}
+	name, module, err := r.Lookup(hdr.Source, hdr.DataVersion)
+	if err != nil {
+		return nil, err
+	}
+	decoded := &DecodedMessage{
+		Header: hdr,
+		Module: name,
+	}
+	switch hdr.Type {
+	case cloudevent.TypeFingerprint:
+		fingerprint, err := module.FingerprintConvert(ctx, msgData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode fingerprint with module '%s': %w", name, err)
+		}
+		decoded.Fingerprint = &fingerprint
+	case TypeEvent:
+		events, err := module.EventConvert(ctx, msgData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode events with module '%s': %w", name, err)
+		}
+		decoded.Events = events
+	case cloudevent.TypeVerifableCredential, cloudevent.TypeUnknown:
+		return nil, UnsupportedError{Module: name, Operation: hdr.Type}
+	default:
+		signals, err := module.SignalConvert(ctx, msgData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode signals with module '%s': %w", name, err)
+		}
+		decoded.Signals = signals
+	}
+	return decoded, nil
+}
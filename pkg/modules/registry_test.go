@@ -0,0 +1,188 @@
+package modules_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/modules"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+)
+
+type testModule struct {
+	name   string
+	config string
+}
+
+func (m *testModule) SignalConvert(context.Context, []byte) ([]vss.Signal, error) {
+	return []vss.Signal{{Name: vss.FieldSpeed, ValueNumber: 1, Source: m.name}}, nil
+}
+
+func (m *testModule) FingerprintConvert(context.Context, []byte) (cloudevent.Fingerprint, error) {
+	return cloudevent.Fingerprint{VIN: m.name}, nil
+}
+
+func (m *testModule) EventConvert(context.Context, []byte) ([]vss.Event, error) {
+	return []vss.Event{{Name: m.name}}, nil
+}
+
+func (m *testModule) CloudEventConvert(_ context.Context, msgData []byte) ([][]byte, error) {
+	return [][]byte{msgData}, nil
+}
+
+func (m *testModule) SetConfig(config string) error {
+	m.config = config
+	return nil
+}
+
+func newTestRegistry(t *testing.T) *modules.Registry {
+	t.Helper()
+	registry := modules.NewRegistry()
+	require.NoError(t, registry.Register("a", &testModule{name: "a"}, "a/v1"))
+	require.NoError(t, registry.Register("b", &testModule{name: "b"}, "b/v1", "b/v2"))
+	require.NoError(t, registry.RegisterSource("0xSourceB", "b"))
+	return registry
+}
+
+func TestRegistryLookup(t *testing.T) {
+	t.Parallel()
+	registry := newTestRegistry(t)
+
+	tests := []struct {
+		name         string
+		source       string
+		dataVersion  string
+		expectedName string
+		expectedErr  bool
+	}{
+		{name: "by data version", source: "unknown", dataVersion: "a/v1", expectedName: "a"},
+		{name: "second data version", source: "unknown", dataVersion: "b/v2", expectedName: "b"},
+		{name: "by source", source: "0xSourceB", dataVersion: "", expectedName: "b"},
+		{name: "source takes precedence", source: "0xSourceB", dataVersion: "a/v1", expectedName: "b"},
+		{name: "unknown", source: "unknown", dataVersion: "c/v1", expectedErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			name, module, err := registry.Lookup(tt.source, tt.dataVersion)
+			if tt.expectedErr {
+				var sourceErr modules.UnknownSourceError
+				require.ErrorAs(t, err, &sourceErr)
+				require.Equal(t, tt.source, sourceErr.Source)
+				require.Equal(t, tt.dataVersion, sourceErr.DataVersion)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedName, name)
+			require.NotNil(t, module)
+		})
+	}
+}
+
+func TestRegistryRegisterErrors(t *testing.T) {
+	t.Parallel()
+	registry := newTestRegistry(t)
+
+	require.Error(t, registry.Register("a", &testModule{}), "duplicate name")
+	require.Error(t, registry.Register("c", &testModule{}, "b/v1"), "duplicate data version")
+	require.Error(t, registry.Register("d", nil), "nil module")
+
+	err := registry.RegisterSource("0xSourceC", "c")
+	var moduleErr modules.UnknownModuleError
+	require.ErrorAs(t, err, &moduleErr)
+	require.Equal(t, "c", moduleErr.Name)
+}
+
+func TestRegistrySetModuleConfig(t *testing.T) {
+	t.Parallel()
+	registry := modules.NewRegistry()
+	module := &testModule{name: "a"}
+	require.NoError(t, registry.Register("a", module))
+	require.NoError(t, registry.SetModuleConfig("a", `{"chainId":137}`))
+	require.Equal(t, `{"chainId":137}`, module.config)
+
+	var moduleErr modules.UnknownModuleError
+	require.ErrorAs(t, registry.SetModuleConfig("b", "{}"), &moduleErr)
+}
+
+func TestRegistryDecode(t *testing.T) {
+	t.Parallel()
+	registry := newTestRegistry(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected *modules.DecodedMessage
+		checkErr func(t *testing.T, err error)
+	}{
+		{
+			name:  "status",
+			input: `{"source":"0xSourceA","dataversion":"a/v1","type":"dimo.status"}`,
+			expected: &modules.DecodedMessage{
+				Module:  "a",
+				Signals: []vss.Signal{{Name: vss.FieldSpeed, ValueNumber: 1, Source: "a"}},
+			},
+		},
+		{
+			name:  "fingerprint",
+			input: `{"source":"0xSourceB","type":"dimo.fingerprint"}`,
+			expected: &modules.DecodedMessage{
+				Module:      "b",
+				Fingerprint: &cloudevent.Fingerprint{VIN: "b"},
+			},
+		},
+		{
+			name:  "event",
+			input: `{"source":"0xSourceB","type":"dimo.event"}`,
+			expected: &modules.DecodedMessage{
+				Module: "b",
+				Events: []vss.Event{{Name: "b"}},
+			},
+		},
+		{
+			name:  "unsupported type",
+			input: `{"source":"0xSourceB","type":"dimo.unknown"}`,
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				var unsupportedErr modules.UnsupportedError
+				require.ErrorAs(t, err, &unsupportedErr)
+			},
+		},
+		{
+			name:  "unknown source",
+			input: `{"source":"0xSourceC","dataversion":"c/v1","type":"dimo.status"}`,
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				var sourceErr modules.UnknownSourceError
+				require.ErrorAs(t, err, &sourceErr)
+			},
+		},
+		{
+			name:  "invalid json",
+			input: `{"source":`,
+			checkErr: func(t *testing.T, err error) {
+				t.Helper()
+				require.Error(t, err)
+				require.False(t, errors.As(err, &modules.UnknownSourceError{}))
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			decoded, err := registry.Decode(ctx, []byte(tt.input))
+			if tt.checkErr != nil {
+				tt.checkErr(t, err)
+				return
+			}
+			require.NoError(t, err)
+			decoded.Header = cloudevent.CloudEventHeader{}
+			require.Equal(t, tt.expected, decoded)
+		})
+	}
+}
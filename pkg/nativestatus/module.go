@@ -0,0 +1,71 @@
+package nativestatus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/modules"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+)
+
+// ModuleName is the name the native status module is registered under.
+// Native status events are identified by their data schema, so their sources must be routed with modules.RegisterSource.
+const ModuleName = "nativestatus"
+
+var registeredModule = &Module{}
+
+func init() {
+	modules.Register(ModuleName, registeredModule)
+}
+
+// SetTokenIDGetter sets the TokenIDGetter used by the registered native status module.
+// It is safe to call while the module is converting messages.
+func SetTokenIDGetter(tokenGetter TokenIDGetter) {
+	registeredModule.SetTokenGetter(tokenGetter)
+}
+
+// Module is the native status source module.
+// A Module must not be copied after first use.
+type Module struct {
+	// TokenGetter is used to resolve v1 payloads that only have a subject.
+	// Use SetTokenGetter to change it once the module is in use.
+	TokenGetter TokenIDGetter
+
+	mu sync.RWMutex
+}
+
+// SetTokenGetter sets the TokenGetter of the module.
+func (m *Module) SetTokenGetter(tokenGetter TokenIDGetter) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.TokenGetter = tokenGetter
+}
+
+// SignalConvert converts a native status CloudEvent into a slice of signals.
+func (m *Module) SignalConvert(ctx context.Context, msgData []byte) ([]vss.Signal, error) {
+	m.mu.RLock()
+	tokenGetter := m.TokenGetter
+	m.mu.RUnlock()
+	return SignalsFromPayload(ctx, tokenGetter, msgData)
+}
+
+// FingerprintConvert is not supported by native status.
+func (m *Module) FingerprintConvert(context.Context, []byte) (cloudevent.Fingerprint, error) {
+	return cloudevent.Fingerprint{}, modules.UnsupportedError{Module: ModuleName, Operation: modules.OperationFingerprint}
+}
+
+// EventConvert is not supported by native status.
+func (m *Module) EventConvert(context.Context, []byte) ([]vss.Event, error) {
+	return nil, modules.UnsupportedError{Module: ModuleName, Operation: modules.OperationEvents}
+}
+
+// CloudEventConvert returns the message unchanged since native status messages are already CloudEvents.
+func (m *Module) CloudEventConvert(_ context.Context, msgData []byte) ([][]byte, error) {
+	if !json.Valid(msgData) {
+		return nil, fmt.Errorf("message is not valid JSON")
+	}
+	return [][]byte{msgData}, nil
+}
@@ -167,3 +167,26 @@ func (t *testGetter) TokenIDFromSubject(_ context.Context, subject string) (uint
 	id, err := strconv.Atoi(subject)
 	return uint32(id), err
 }
+
+func TestModuleSetTokenGetter(t *testing.T) {
+	t.Parallel()
+	module := &nativestatus.Module{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 100 {
+			module.SetTokenGetter(&tokenGetter{})
+		}
+	}()
+	for range 100 {
+		_, _ = module.SignalConvert(context.Background(), []byte(fullInputJSON))
+	}
+	<-done
+	signals, err := module.SignalConvert(context.Background(), []byte(fullInputJSON))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(signals) == 0 {
+		t.Errorf("Expected signals after setting the token getter")
+	}
+}
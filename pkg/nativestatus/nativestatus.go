@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"strings"
 
@@ -36,6 +37,14 @@ func SignalsFromPayload(ctx context.Context, tokenGetter TokenIDGetter, jsonData
 	}
 }
 
+// SignalsFromPayloadBatch extracts signals from each payload of a batch of CloudEvents.
+// Errors for a single payload are returned with its signals, the error is only set if the batch is malformed.
+func SignalsFromPayloadBatch(ctx context.Context, tokenGetter TokenIDGetter, r io.Reader) ([]convert.EventSignals, error) {
+	return convert.DecodeBatch(r, func(jsonData []byte) ([]vss.Signal, error) {
+		return SignalsFromPayload(ctx, tokenGetter, jsonData)
+	})
+}
+
 // GetSchemaVersion returns the version string of the schema used in the payload.
 func GetSchemaVersion(jsonData []byte) string {
 	dataSchema := gjson.GetBytes(jsonData, "dataschema")
@@ -4,9 +4,11 @@ import (
 	"cmp"
 	"context"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/DIMO-Network/model-garage/pkg/convert"
 	"github.com/DIMO-Network/model-garage/pkg/nativestatus"
 	"github.com/DIMO-Network/model-garage/pkg/vss"
 	"github.com/stretchr/testify/require"
@@ -89,26 +91,26 @@ var (
 
 	expectedSignals = []vss.Signal{
 		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow1WheelLeftTirePressure", ValueNumber: 30.5, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow1WheelRightTirePressure", ValueNumber: 31, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow1WheelRightTirePressure", ValueNumber: 31, ValueInt: 31, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow2WheelLeftTirePressure", ValueNumber: 32.2, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "chassisAxleRow2WheelRightTirePressure", ValueNumber: 33.1, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryCurrentPower", ValueNumber: 34000.0, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "currentLocationAltitude", ValueNumber: 100, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "currentLocationLatitude", ValueNumber: 37.7749, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "currentLocationLongitude", ValueNumber: -122.4194, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "currentLocationIsRedacted", ValueNumber: 1, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineECT", ValueNumber: 90, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "currentLocationIsRedacted", ValueNumber: 1, ValueBool: true, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineECT", ValueNumber: 90, ValueInt: 90, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineEngineOilLevel", ValueString: "CRITICALLY_LOW", Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineEngineOilRelativeLevel", ValueNumber: 10, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineSpeed", ValueNumber: 3000, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineTPS", ValueNumber: 50, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainFuelSystemRelativeLevel", ValueNumber: 60, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainFuelSystemSupportedFuelTypes", ValueString: "GASOLINE", Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainRange", ValueNumber: 300, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineSpeed", ValueNumber: 3000, ValueInt: 3000, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainCombustionEngineTPS", ValueNumber: 50, ValueInt: 50, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainFuelSystemRelativeLevel", ValueNumber: 60, ValueInt: 60, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainFuelSystemSupportedFuelTypes", ValueString: "GASOLINE", ValueStringArray: []string{"GASOLINE"}, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainRange", ValueNumber: 300, ValueInt: 300, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainType", ValueString: "COMBUSTION", Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryChargingChargeLimit", ValueNumber: 80, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryChargingIsCharging", ValueNumber: 1, Source: "dimo/integration/123"},
-		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryGrossCapacity", ValueNumber: 60, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryChargingChargeLimit", ValueNumber: 80, ValueInt: 80, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryChargingIsCharging", ValueNumber: 1, ValueBool: true, Source: "dimo/integration/123"},
+		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryGrossCapacity", ValueNumber: 60, ValueInt: 60, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainTractionBatteryStateOfChargeCurrent", ValueNumber: 70, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "powertrainTransmissionTravelledDistance", ValueNumber: 50000, Source: "dimo/integration/123"},
 		{TokenID: 123, Timestamp: ts, Name: "speed", ValueNumber: 60, Source: "dimo/integration/123"},
@@ -177,3 +179,64 @@ var (
 		{TokenID: 123, Timestamp: ts, Name: "powertrainTransmissionTravelledDistance", ValueNumber: 5024, Source: "dimo/integration/123"},
 	}
 )
+
+func TestOutOfRangeValues(t *testing.T) {
+	t.Parallel()
+	input := `{
+		"id": "randomIDnumber",
+		"specversion": "1.0",
+		"source": "dimo/integration/123",
+		"subject": "Vehicle123",
+		"time": "2022-01-01T12:34:56Z",
+		"type": "DIMO",
+		"vehicleTokenId": 123,
+		"data": {
+			"latitude": 900.0,
+			"odometer": -5.0,
+			"speed": 25.0
+		}
+	}`
+	_, err := nativestatus.SignalsFromPayload(context.Background(), nil, []byte(input))
+	var convertErr convert.ConversionError
+	require.ErrorAs(t, err, &convertErr)
+	require.Len(t, convertErr.Errors, 2)
+	var rangeErr convert.OutOfRangeError
+	require.ErrorAs(t, err, &rangeErr)
+	require.Contains(t, []any{900.0, -5.0}, rangeErr.Raw)
+	expectedSignals := []vss.Signal{
+		{TokenID: 123, Timestamp: ts, Name: "speed", ValueNumber: 25.0, Source: "dimo/integration/123"},
+	}
+	require.Equal(t, expectedSignals, convertErr.DecodedSignals)
+}
+
+func TestSignalsFromPayloadBatch(t *testing.T) {
+	t.Parallel()
+	outOfRange := `{
+		"source": "dimo/integration/123",
+		"time": "2022-01-01T12:34:56Z",
+		"vehicleTokenId": 123,
+		"data": {"odometer": -5.0, "speed": 25.0}
+	}`
+	batch := "[" + inputJSONWithTokenID + "," + outOfRange + `,{"dataschema":"dimo.zone.status/v3.0.0"}]`
+	results, err := nativestatus.SignalsFromPayloadBatch(context.Background(), nil, strings.NewReader(batch))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.NoError(t, results[0].Err)
+	require.ElementsMatch(t, expectedSignalsWithFromTokenID, results[0].Signals)
+
+	// the signals decoded before the error are kept from the conversion error.
+	require.Equal(t, 1, results[1].Index)
+	var rangeErr convert.OutOfRangeError
+	require.ErrorAs(t, results[1].Err, &rangeErr)
+	require.Equal(t, []vss.Signal{
+		{TokenID: 123, Timestamp: ts, Name: "speed", ValueNumber: 25.0, Source: "dimo/integration/123"},
+	}, results[1].Signals)
+
+	require.Equal(t, 2, results[2].Index)
+	require.Equal(t, convert.VersionError{Version: "v3.0.0"}, results[2].Err)
+	require.Empty(t, results[2].Signals)
+
+	_, err = nativestatus.SignalsFromPayloadBatch(context.Background(), nil, strings.NewReader(inputJSONWithTokenID))
+	require.Error(t, err, "a single event is not a batch")
+}
@@ -475,7 +475,7 @@ func ToPowertrainTransmissionTemperature0(originalDoc []byte, val float64) (floa
 
 // ToPowertrainTransmissionTravelledDistance0 converts data from field 'odometer' of type float64 to 'Vehicle.Powertrain.Transmission.TravelledDistance' of type float64.
 // Vehicle.Powertrain.Transmission.TravelledDistance: Odometer reading, total distance travelled during the lifetime of the transmission.
-// Unit: 'km'
+// Unit: 'km' Min: '0'
 func ToPowertrainTransmissionTravelledDistance0(originalDoc []byte, val float64) (float64, error) {
 	if val > 999999 {
 		// if the value is absurdly high, it is likely in meters, convert to kilometers
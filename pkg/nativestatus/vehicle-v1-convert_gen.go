@@ -4,13 +4,14 @@ package nativestatus
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/DIMO-Network/model-garage/pkg/convert"
 	"github.com/DIMO-Network/model-garage/pkg/vss"
 	"github.com/tidwall/gjson"
 )
 
-var errNotFound = errors.New("field not found")
+var errNotFound = convert.ErrNotFound
 
 // SignalsFromV1Data creates a slice of vss.Signal from the given v1 status JSON data.
 // On error, partial results may be returned.
@@ -33,8 +34,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'AngularVelocityYaw': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow1WheelLeftSpeedFromV1Data(jsonData)
@@ -49,8 +53,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow1WheelLeftSpeed': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow1WheelLeftTirePressureFromV1Data(jsonData)
@@ -65,8 +72,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow1WheelLeftTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow1WheelRightSpeedFromV1Data(jsonData)
@@ -81,8 +91,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow1WheelRightSpeed': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow1WheelRightTirePressureFromV1Data(jsonData)
@@ -97,8 +110,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow1WheelRightTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow2WheelLeftTirePressureFromV1Data(jsonData)
@@ -113,8 +129,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow2WheelLeftTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow2WheelRightTirePressureFromV1Data(jsonData)
@@ -129,8 +148,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow2WheelRightTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = CurrentLocationAltitudeFromV1Data(jsonData)
@@ -145,8 +167,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("double", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationAltitude': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = CurrentLocationIsRedactedFromV1Data(jsonData)
@@ -161,8 +186,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("boolean", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationIsRedacted': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = CurrentLocationLatitudeFromV1Data(jsonData)
@@ -177,8 +205,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("double", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationLatitude': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = CurrentLocationLongitudeFromV1Data(jsonData)
@@ -193,8 +224,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("double", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationLongitude': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = DIMOAftermarketHDOPFromV1Data(jsonData)
@@ -209,8 +243,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'DIMOAftermarketHDOP': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = DIMOAftermarketNSATFromV1Data(jsonData)
@@ -225,8 +262,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'DIMOAftermarketNSAT': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = DIMOAftermarketSSIDFromV1Data(jsonData)
@@ -241,8 +281,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("string", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'DIMOAftermarketSSID': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = DIMOAftermarketWPAStateFromV1Data(jsonData)
@@ -257,8 +300,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("string", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'DIMOAftermarketWPAState': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ExteriorAirTemperatureFromV1Data(jsonData)
@@ -273,8 +319,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ExteriorAirTemperature': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = LowVoltageBatteryCurrentVoltageFromV1Data(jsonData)
@@ -289,8 +338,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'LowVoltageBatteryCurrentVoltage': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDBarometricPressureFromV1Data(jsonData)
@@ -305,8 +357,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDBarometricPressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDCommandedEGRFromV1Data(jsonData)
@@ -321,8 +376,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDCommandedEGR': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDCommandedEVAPFromV1Data(jsonData)
@@ -337,8 +395,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDCommandedEVAP': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDDistanceSinceDTCClearFromV1Data(jsonData)
@@ -353,8 +414,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDDistanceSinceDTCClear': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDDistanceWithMILFromV1Data(jsonData)
@@ -369,8 +433,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDDistanceWithMIL': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDEngineLoadFromV1Data(jsonData)
@@ -385,8 +452,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDEngineLoad': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDFuelPressureFromV1Data(jsonData)
@@ -401,8 +471,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDFuelPressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDIntakeTempFromV1Data(jsonData)
@@ -417,8 +490,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDIntakeTemp': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDLongTermFuelTrim1FromV1Data(jsonData)
@@ -433,8 +509,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDLongTermFuelTrim1': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDMAPFromV1Data(jsonData)
@@ -449,8 +528,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDMAP': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDO2WRSensor1VoltageFromV1Data(jsonData)
@@ -465,8 +547,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDO2WRSensor1Voltage': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDO2WRSensor2VoltageFromV1Data(jsonData)
@@ -481,8 +566,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDO2WRSensor2Voltage': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDRunTimeFromV1Data(jsonData)
@@ -497,8 +585,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDRunTime': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDShortTermFuelTrim1FromV1Data(jsonData)
@@ -513,8 +604,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDShortTermFuelTrim1': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDWarmupsSinceDTCClearFromV1Data(jsonData)
@@ -529,8 +623,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDWarmupsSinceDTCClear': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineECTFromV1Data(jsonData)
@@ -545,8 +642,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("int16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineECT': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineEngineOilLevelFromV1Data(jsonData)
@@ -561,8 +661,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("string", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineEngineOilLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineEngineOilRelativeLevelFromV1Data(jsonData)
@@ -577,8 +680,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineEngineOilRelativeLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineMAFFromV1Data(jsonData)
@@ -593,8 +699,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineMAF': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineSpeedFromV1Data(jsonData)
@@ -609,8 +718,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineSpeed': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineTPSFromV1Data(jsonData)
@@ -625,8 +737,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineTPS': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineTorqueFromV1Data(jsonData)
@@ -641,8 +756,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineTorque': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainFuelSystemAbsoluteLevelFromV1Data(jsonData)
@@ -657,8 +775,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainFuelSystemAbsoluteLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainFuelSystemRelativeLevelFromV1Data(jsonData)
@@ -673,8 +794,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainFuelSystemRelativeLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainFuelSystemSupportedFuelTypesFromV1Data(jsonData)
@@ -689,7 +813,9 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
+		// conversions return a single array element, which is also kept as the string value
+		sig.SetValue([]string{val.(string)})
+		sig.ValueString = fmt.Sprint(val)
 		retSignals = append(retSignals, sig)
 	}
 
@@ -705,8 +831,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint32", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainRange': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryChargingChargeLimitFromV1Data(jsonData)
@@ -721,8 +850,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryChargingChargeLimit': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryChargingIsChargingFromV1Data(jsonData)
@@ -737,8 +869,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("boolean", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryChargingIsCharging': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryCurrentPowerFromV1Data(jsonData)
@@ -753,8 +888,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryCurrentPower': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryCurrentVoltageFromV1Data(jsonData)
@@ -769,8 +907,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryCurrentVoltage': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryGrossCapacityFromV1Data(jsonData)
@@ -785,8 +926,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryGrossCapacity': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryStateOfChargeCurrentFromV1Data(jsonData)
@@ -801,8 +945,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryStateOfChargeCurrent': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryTemperatureAverageFromV1Data(jsonData)
@@ -817,8 +964,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryTemperatureAverage': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTransmissionCurrentGearFromV1Data(jsonData)
@@ -833,8 +983,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("int8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTransmissionCurrentGear': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTransmissionTemperatureFromV1Data(jsonData)
@@ -849,8 +1002,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("int16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTransmissionTemperature': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTransmissionTravelledDistanceFromV1Data(jsonData)
@@ -865,8 +1021,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTransmissionTravelledDistance': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTypeFromV1Data(jsonData)
@@ -881,8 +1040,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("string", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainType': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ServiceDistanceToServiceFromV1Data(jsonData)
@@ -897,8 +1059,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ServiceDistanceToService': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = SpeedFromV1Data(jsonData)
@@ -913,8 +1078,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'Speed': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 	return retSignals, errs
 }
@@ -1205,6 +1373,9 @@ func CurrentLocationLatitudeFromV1Data(jsonData []byte) (ret float64, err error)
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToCurrentLocationLatitude0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, -90, 90)
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -1230,6 +1401,9 @@ func CurrentLocationLongitudeFromV1Data(jsonData []byte) (ret float64, err error
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToCurrentLocationLongitude0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, -180, 180)
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -1844,6 +2018,9 @@ func PowertrainCombustionEngineEngineOilLevelFromV1Data(jsonData []byte) (ret st
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToPowertrainCombustionEngineEngineOilLevel0(jsonData, val)
+			if err == nil {
+				err = convert.CheckAllowed(retVal, "CRITICALLY_LOW", "LOW", "NORMAL", "HIGH", "CRITICALLY_HIGH")
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -1857,6 +2034,9 @@ func PowertrainCombustionEngineEngineOilLevelFromV1Data(jsonData []byte) (ret st
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToPowertrainCombustionEngineEngineOilLevel1(jsonData, val)
+			if err == nil {
+				err = convert.CheckAllowed(retVal, "CRITICALLY_LOW", "LOW", "NORMAL", "HIGH", "CRITICALLY_HIGH")
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -2083,6 +2263,9 @@ func PowertrainFuelSystemSupportedFuelTypesFromV1Data(jsonData []byte) (ret stri
 		val, ok := result.Value().(string)
 		if ok {
 			retVal, err := ToPowertrainFuelSystemSupportedFuelTypes0(jsonData, val)
+			if err == nil {
+				err = convert.CheckAllowed(retVal, "GASOLINE", "DIESEL", "E85", "LPG", "CNG", "LNG", "H2", "OTHER")
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -2358,6 +2541,9 @@ func PowertrainTransmissionTravelledDistanceFromV1Data(jsonData []byte) (ret flo
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToPowertrainTransmissionTravelledDistance0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, 0, math.Inf(1))
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -2383,6 +2569,9 @@ func PowertrainTypeFromV1Data(jsonData []byte) (ret string, err error) {
 		val, ok := result.Value().(string)
 		if ok {
 			retVal, err := ToPowertrainType0(jsonData, val)
+			if err == nil {
+				err = convert.CheckAllowed(retVal, "COMBUSTION", "HYBRID", "ELECTRIC")
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -8,6 +8,9 @@ import (
 
 	"github.com/DIMO-Network/model-garage/internal/generator/convert"
 	"github.com/DIMO-Network/model-garage/internal/generator/custom"
+	"github.com/DIMO-Network/model-garage/internal/generator/graphql"
+	"github.com/DIMO-Network/model-garage/internal/generator/jsonschema"
+	"github.com/DIMO-Network/model-garage/internal/generator/proto"
 	"github.com/DIMO-Network/model-garage/pkg/schema"
 )
 
@@ -18,12 +21,21 @@ const (
 	ConvertGenerator = "convert"
 	// CustomGenerator is a constant to run the custom generator.
 	CustomGenerator = "custom"
+	// JSONSchemaGenerator is a constant to run the JSON Schema generator.
+	JSONSchemaGenerator = "jsonschema"
+	// GraphQLGenerator is a constant to run the GraphQL schema generator.
+	GraphQLGenerator = "graphql"
+	// ProtoGenerator is a constant to run the protobuf schema generator.
+	ProtoGenerator = "proto"
 )
 
 // Config is the configuration for the code generation tool.
 type Config struct {
-	Custom  custom.Config
-	Convert convert.Config
+	Custom     custom.Config
+	Convert    convert.Config
+	JSONSchema jsonschema.Config
+	GraphQL    graphql.Config
+	Proto      proto.Config
 }
 
 // Execute runs the code generation tool.
@@ -36,6 +48,9 @@ func Execute(vspecReader, definitionsReader io.Reader, generators []string, cfg
 	case slices.Contains(generators, AllGenerator):
 	case slices.Contains(generators, ConvertGenerator):
 	case slices.Contains(generators, CustomGenerator):
+	case slices.Contains(generators, JSONSchemaGenerator):
+	case slices.Contains(generators, GraphQLGenerator):
+	case slices.Contains(generators, ProtoGenerator):
 	default:
 		return fmt.Errorf("no generator selected")
 	}
@@ -59,5 +74,26 @@ func Execute(vspecReader, definitionsReader io.Reader, generators []string, cfg
 		}
 	}
 
+	if slices.Contains(generators, AllGenerator) || slices.Contains(generators, JSONSchemaGenerator) {
+		err = jsonschema.Generate(tmplData, cfg.JSONSchema)
+		if err != nil {
+			return fmt.Errorf("failed to generate JSON Schema file: %w", err)
+		}
+	}
+
+	if slices.Contains(generators, AllGenerator) || slices.Contains(generators, GraphQLGenerator) {
+		err = graphql.Generate(tmplData, cfg.GraphQL)
+		if err != nil {
+			return fmt.Errorf("failed to generate GraphQL file: %w", err)
+		}
+	}
+
+	if slices.Contains(generators, AllGenerator) || slices.Contains(generators, ProtoGenerator) {
+		err = proto.Generate(tmplData, cfg.Proto)
+		if err != nil {
+			return fmt.Errorf("failed to generate protobuf file: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -5,18 +5,22 @@ import (
 	"bytes"
 	_ "embed"
 	"fmt"
-	"math/big"
-	"os"
+	"slices"
+	"strconv"
 	"strings"
 	"text/template"
+	"unicode"
 
 	"github.com/DIMO-Network/model-garage/pkg/codegen"
 	rupschema "github.com/DIMO-Network/model-garage/pkg/ruptela/schema"
 	"github.com/DIMO-Network/model-garage/pkg/schema"
 )
 
-//go:embed functions.tmpl
-var functionsTemplate string
+//go:embed models.tmpl
+var modelsTemplate string
+
+//go:embed raw-oids.tmpl
+var rawOIDsTemplate string
 
 func main() {
 	oidMap, err := loadCSVToMap(rupschema.OIDCSV())
@@ -24,87 +28,180 @@ func main() {
 		panic(err)
 	}
 
-	vssReader := strings.NewReader(schema.VssRel42DIMO())
-	defReader := strings.NewReader(rupschema.RuptelaDefinitionsYAML())
-	sigs, err := schema.GetDefinedSignals(vssReader, defReader)
-	if err != nil {
-		panic(err)
+	var signals []*schema.SignalInfo
+	statusOIDs := make(map[string]bool)
+	devStatusOIDs := make(map[string]bool)
+	for i, definitions := range []string{rupschema.RuptelaDefinitionsYAML(), rupschema.DevStatusDefinitionsYAML()} {
+		vssReader := strings.NewReader(schema.VssRel42DIMO())
+		defReader := strings.NewReader(definitions)
+		sigs, err := schema.GetDefinedSignals(vssReader, defReader)
+		if err != nil {
+			panic(err)
+		}
+		signals = append(signals, sigs.Signals...)
+		converted := statusOIDs
+		if i != 0 {
+			converted = devStatusOIDs
+		}
+		for _, sig := range sigs.Signals {
+			for _, conv := range sig.Conversions {
+				if oid, ok := strings.CutPrefix(conv.OriginalName, "signals."); ok {
+					converted[oid] = true
+				}
+			}
+		}
 	}
-	createRecords := make(map[string]Record)
-	for _, sig := range sigs.Signals {
+	oidSignals := make(map[string][]string)
+	var commonSignals []string
+	for _, sig := range signals {
 		for _, conv := range sig.Conversions {
-			parts := strings.Split(conv.OriginalName, ".")
-			if len(parts) < 1 || parts[0] != "signals" {
-				continue
-			}
-			oid := parts[1]
-			record, ok := oidMap[oid]
+			oid, ok := strings.CutPrefix(conv.OriginalName, "signals.")
 			if !ok {
+				commonSignals = append(commonSignals, sig.JSONName)
 				continue
 			}
-			offset, multiplier, err := getMultiplierAndOffset(record.MultiplierOffset)
-			if err != nil {
-				panic(err)
-			}
-			record.Multiplier = multiplier
-			record.Offset = offset
-			record.ErrorRange, record.ErrorSet, err = getErrorRange(record.ErrorValues)
-			if err != nil {
-				panic(err)
-			}
-			record.MinBig, err = getMinOrMax(record.MinValue)
-			if err != nil {
-				panic(err)
-			}
-			record.MaxBig, err = getMinOrMax(record.MaxValue)
-			if err != nil {
-				panic(err)
-			}
-			createRecords[oid] = record
+			oidSignals[oid] = append(oidSignals[oid], sig.JSONName)
 		}
 	}
-	err = createFuncs(createRecords)
+	models, err := loadModels(rupschema.OIDCSV())
+	if err != nil {
+		panic(err)
+	}
+	err = createModels(models, oidMap, oidSignals, commonSignals)
+	if err != nil {
+		panic(err)
+	}
+	err = createRawOIDs(oidMap, statusOIDs, devStatusOIDs)
 	if err != nil {
 		panic(err)
 	}
 	return
 }
 
-func createFuncs(records map[string]Record) error {
-	tmpl, err := template.New("ruptela-convert-functions").Funcs(
+// rawOID is an OID that is decoded with the raw OID table.
+type rawOID struct {
+	Record
+	ID uint16
+	// Status is true if the OID is converted by the status definitions.
+	Status bool
+	// DevStatus is true if the OID is converted by the device status definitions.
+	DevStatus bool
+}
+
+// skippedOID is a numeric OID that is not decoded because its row of the OID table could not be interpreted.
+type skippedOID struct {
+	ID     uint16
+	Reason string
+}
+
+func createRawOIDs(records map[string]Record, statusOIDs, devStatusOIDs map[string]bool) error {
+	tmpl, err := template.New("ruptela-raw-oids").Funcs(
 		template.FuncMap{
-			"bigText": bigText,
+			"unit":    rawUnit,
+			"minBits": minBits,
+			"maxBits": maxBits,
 		},
-	).Parse(functionsTemplate)
-	outputFile := "pkg/ruptela/multiplier-offset.go"
-	customFileOutputFile, err := os.Create(outputFile)
+	).Parse(rawOIDsTemplate)
 	if err != nil {
-		return fmt.Errorf("error creating Custom output file: %w", err)
+		return fmt.Errorf("error parsing raw OIDs template: %w", err)
 	}
-	defer func() {
-		if cerr := customFileOutputFile.Close(); err == nil && cerr != nil {
-			err = cerr
+	var oids []rawOID
+	var skipped []skippedOID
+	for oid, record := range records {
+		if !isNumeric(record.Type) || !slices.Contains([]int{1, 2, 4, 8}, record.Size) {
+			continue
 		}
-	}()
+		id, err := strconv.ParseUint(oid, 10, 16)
+		if err != nil {
+			return fmt.Errorf("error parsing OID '%s': %w", oid, err)
+		}
+		record, err = interpret(record)
+		if err != nil {
+			// The table has free text in some columns, such OIDs are not decoded and listed in the generated file.
+			reason := strings.Join(strings.Fields(err.Error()), " ")
+			reason = strings.Map(func(r rune) rune {
+				if unicode.IsPrint(r) {
+					return r
+				}
+				return -1
+			}, reason)
+			skipped = append(skipped, skippedOID{ID: uint16(id), Reason: reason})
+			continue
+		}
+		oids = append(oids, rawOID{Record: record, ID: uint16(id), Status: statusOIDs[oid], DevStatus: devStatusOIDs[oid]})
+	}
+	slices.SortFunc(oids, func(a, b rawOID) int { return int(a.ID) - int(b.ID) })
+	slices.SortFunc(skipped, func(a, b skippedOID) int { return int(a.ID) - int(b.ID) })
+
+	var outBuf bytes.Buffer
+	err = tmpl.Execute(&outBuf, struct {
+		OIDs    []rawOID
+		Skipped []skippedOID
+	}{OIDs: oids, Skipped: skipped})
+	if err != nil {
+		return fmt.Errorf("error executing raw OIDs template: %w", err)
+	}
+	err = codegen.FormatAndWriteToFile(outBuf.Bytes(), "pkg/ruptela/raw-oids_gen.go")
+	if err != nil {
+		return fmt.Errorf("error writing file: %w", err)
+	}
+	return nil
+}
+
+// modelOID is the model support of a single OID.
+type modelOID struct {
+	OID     uint16
+	Mask    uint32
+	Signals []string
+}
+
+func createModels(models []Model, records map[string]Record, oidSignals map[string][]string, commonSignals []string) error {
+	tmpl, err := template.New("ruptela-models").Parse(modelsTemplate)
+	if err != nil {
+		return fmt.Errorf("error parsing models template: %w", err)
+	}
+	var oids []modelOID
+	for oid, record := range records {
+		id, err := strconv.ParseUint(oid, 10, 16)
+		if err != nil {
+			return fmt.Errorf("error parsing OID '%s': %w", oid, err)
+		}
+		var mask uint32
+		for i, model := range models {
+			if record.Supports(model) {
+				mask |= 1 << i
+			}
+		}
+		if mask == 0 {
+			continue
+		}
+		oids = append(oids, modelOID{OID: uint16(id), Mask: mask, Signals: uniqueSorted(oidSignals[oid])})
+	}
+	slices.SortFunc(oids, func(a, b modelOID) int { return int(a.OID) - int(b.OID) })
 
 	var outBuf bytes.Buffer
 	data := struct {
-		Records map[string]Record
+		Models        []Model
+		OIDs          []modelOID
+		CommonSignals []string
 	}{
-		Records: records,
+		Models:        models,
+		OIDs:          oids,
+		CommonSignals: uniqueSorted(commonSignals),
 	}
 	err = tmpl.Execute(&outBuf, &data)
 	if err != nil {
-		return fmt.Errorf("error executing Custom template: %w", err)
+		return fmt.Errorf("error executing models template: %w", err)
 	}
-	err = codegen.FormatAndWriteToFile(outBuf.Bytes(), outputFile)
+	err = codegen.FormatAndWriteToFile(outBuf.Bytes(), "pkg/ruptela/models_gen.go")
 	if err != nil {
 		return fmt.Errorf("error writing file: %w", err)
 	}
 	return nil
-
 }
 
-func bigText(b *big.Int) string {
-	return b.Text(10)
+func uniqueSorted(names []string) []string {
+	names = slices.Clone(names)
+	slices.Sort(names)
+	return slices.Compact(names)
 }
@@ -3,11 +3,17 @@ package main
 import (
 	"encoding/csv"
 	"fmt"
+	"math"
 	"math/big"
+	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 )
 
+// notesRegex matches notes such as "(1 for upper bit)" in the multiplier and offset column.
+var notesRegex = regexp.MustCompile(`\([^)]*\)`)
+
 // Record defines a struct to hold the data for each oid.
 type Record struct {
 	OID                   string
@@ -52,17 +58,61 @@ type Record struct {
 	// Interpreted values
 	Offset     float64
 	Multiplier float64
+	// Signed is true if the raw value is a two's complement signed integer.
+	Signed     bool
 	ErrorRange *ErrorRange
 	ErrorSet   []uint64
 	MinBig     *big.Int
 	MaxBig     *big.Int
 }
 
+// modelIdents are the Record fields of the hardware model columns in the order they appear in the OID table.
+var modelIdents = []string{
+	"Eco5", "Plug5", "HCV5LitePro5Lite", "Pro5", "HCV5", "LCV5", "Trace5LTM", "Trace5LTE", "Basic",
+	"ECO4UBI", "Eco4", "Eco4S", "Eco4T", "Eco4RST", "Pro4", "Tco4HCV", "Tco4LCV", "Plug4", "Pro4BT", "Tco4HCVBT", "Tco4LCVBT",
+	"Eco3", "Pro3", "Tco3TCO", "Tco3OBD",
+}
+
+// firstModelColumn is the column of the first hardware model in the OID table.
+const firstModelColumn = 13
+
+// Model is a hardware model column of the OID table.
+type Model struct {
+	// Ident is the name of the Record field and generated constant for the model.
+	Ident string
+	// Name is the name of the model in the OID table header.
+	Name string
+}
+
 type ErrorRange struct {
 	Min uint64
 	Max uint64
 }
 
+// loadModels returns the hardware models from the header of the OID table.
+func loadModels(oidCSV string) ([]Model, error) {
+	reader := csv.NewReader(strings.NewReader(oidCSV))
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read headers: %w", err)
+	}
+	if len(header) < firstModelColumn+len(modelIdents) {
+		return nil, fmt.Errorf("header has %d columns but %d model columns are expected", len(header), len(modelIdents))
+	}
+	models := make([]Model, len(modelIdents))
+	for i, ident := range modelIdents {
+		models[i] = Model{Ident: ident, Name: strings.TrimSpace(header[firstModelColumn+i])}
+	}
+	return models, nil
+}
+
+// Supports returns true if the OID table marks the OID as supported by the model.
+func (r Record) Supports(model Model) bool {
+	value := reflect.ValueOf(r).FieldByName(model.Ident).String()
+	return strings.EqualFold(strings.TrimSpace(value), "yes")
+}
+
 // Function to load CSV and parse it into a map.
 func loadCSVToMap(oidCSV string) (map[string]Record, error) {
 	reader := csv.NewReader(strings.NewReader(oidCSV))
@@ -140,9 +190,12 @@ func loadCSVToMap(oidCSV string) (map[string]Record, error) {
 }
 
 func getMultiplierAndOffset(multiplierOffset string) (offset float64, multiplier float64, err error) {
-	if strings.Contains(multiplierOffset, "or") {
-		return 0, 1, nil
-	}
+	multiplierOffset = strings.TrimSpace(notesRegex.ReplaceAllString(multiplierOffset, ""))
+	// Values with parts that use different multipliers list them in the order of the parts, such as "0.5 or 2".
+	// A single conversion can only apply one, so the multiplier of the first part is used.
+	multiplierOffset, _, _ = strings.Cut(multiplierOffset, " or ")
+	multiplierOffset = strings.TrimSpace(multiplierOffset)
+	multiplier = 1
 	parts := strings.Split(multiplierOffset, ";")
 	if len(parts) == 2 {
 		offsetStr := strings.ReplaceAll(parts[1], "offset", "")
@@ -258,3 +311,100 @@ func getMinOrMax(rawVal string) (*big.Int, error) {
 	}
 	return bigInt, nil
 }
+
+// interpret parses the multiplier, offset, error values and bounds of the record.
+func interpret(record Record) (Record, error) {
+	var err error
+	record.Offset, record.Multiplier, err = getMultiplierAndOffset(record.MultiplierOffset)
+	if err != nil {
+		return record, err
+	}
+	record.ErrorRange, record.ErrorSet, err = getErrorRange(record.ErrorValues)
+	if err != nil {
+		return record, err
+	}
+	record.MinBig, err = getMinOrMax(record.MinValue)
+	if err != nil {
+		return record, err
+	}
+	record.MaxBig, err = getMinOrMax(record.MaxValue)
+	if err != nil {
+		return record, err
+	}
+	record.Signed = isSigned(record.Type)
+	record.MinBig, record.MaxBig = dropTypeBounds(record.MinBig, record.MaxBig, record.Size, record.Signed)
+	return record, nil
+}
+
+// rawUnit returns the unit of an OID or an empty string if the value has no unit.
+func rawUnit(units string) string {
+	units = strings.TrimSpace(units)
+	if units == "-" {
+		return ""
+	}
+	return units
+}
+
+// minBits returns the raw bits of the lowest valid value of the record.
+func minBits(record Record) uint64 {
+	if record.MinBig != nil {
+		return twosComplement(record.MinBig)
+	}
+	if record.Signed {
+		return twosComplement(big.NewInt(math.MinInt64))
+	}
+	return 0
+}
+
+// maxBits returns the raw bits of the highest valid value of the record.
+func maxBits(record Record) uint64 {
+	if record.MaxBig != nil {
+		return twosComplement(record.MaxBig)
+	}
+	if record.Signed {
+		return math.MaxInt64
+	}
+	return math.MaxUint64
+}
+
+// twosComplement returns the 64 bit two's complement of the value.
+func twosComplement(val *big.Int) uint64 {
+	if val.Sign() < 0 {
+		return new(big.Int).Add(val, new(big.Int).Lsh(big.NewInt(1), 64)).Uint64()
+	}
+	return val.Uint64()
+}
+
+// isSigned returns true if the OID type is a two's complement signed integer.
+func isSigned(oidType string) bool {
+	return strings.TrimSpace(oidType) == "Signed int."
+}
+
+// isNumeric returns true if the OID type is an integer that can be decoded with its multiplier and offset.
+func isNumeric(oidType string) bool {
+	switch strings.TrimSpace(oidType) {
+	case "Unsigned int.", "Unsighed int.", "Signed int.":
+		return true
+	default:
+		return false
+	}
+}
+
+// dropTypeBounds returns nil for a min or max that every parsed value satisfies so that no dead check is generated for it.
+// Unsigned values are parsed as a uint64 and signed values are sign extended from their size.
+func dropTypeBounds(minBig, maxBig *big.Int, size int, signed bool) (*big.Int, *big.Int) {
+	typeMin := big.NewInt(0)
+	typeMax := new(big.Int).SetUint64(math.MaxUint64)
+	if signed && size > 0 && size <= 8 {
+		bits := uint(size * 8)
+		typeMin = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), bits-1))
+		typeMax = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits-1), big.NewInt(1))
+	}
+	if minBig != nil && minBig.Cmp(typeMin) <= 0 {
+		minBig = nil
+	}
+	if maxBig != nil && maxBig.Cmp(typeMax) >= 0 {
+		maxBig = nil
+	}
+	return minBig, maxBig
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	rupschema "github.com/DIMO-Network/model-garage/pkg/ruptela/schema"
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMultiplierAndOffset(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		input              string
+		expectedMultiplier float64
+		expectedOffset     float64
+	}{
+		{input: "-", expectedMultiplier: 1},
+		{input: "0.1; -250", expectedMultiplier: 0.1, expectedOffset: -250},
+		{input: "1/256; offset -125", expectedMultiplier: 1.0 / 256, expectedOffset: -125},
+		{input: "-125 offset", expectedMultiplier: 1, expectedOffset: -125},
+		{input: "1/256  (1 for upper bit)", expectedMultiplier: 1.0 / 256},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			offset, multiplier, err := getMultiplierAndOffset(tt.input)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedMultiplier, multiplier)
+			require.Equal(t, tt.expectedOffset, offset)
+		})
+	}
+
+	// the multiplier of the first part is used when parts of the value have different multipliers.
+	offset, multiplier, err := getMultiplierAndOffset("0.5 or 2")
+	require.NoError(t, err)
+	require.Equal(t, 0.5, multiplier)
+	require.Equal(t, 0.0, offset)
+}
+
+func TestMultiplierAlternatives(t *testing.T) {
+	t.Parallel()
+	oidMap, err := loadCSVToMap(rupschema.OIDCSV())
+	require.NoError(t, err)
+	var oids []string
+	for oid, record := range oidMap {
+		if strings.Contains(record.MultiplierOffset, " or ") {
+			oids = append(oids, oid)
+		}
+	}
+	require.Equal(t, []string{"741"}, oids, "only OID 741 lists multiplier alternatives")
+}
+
+func TestDropTypeBounds(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name        string
+		minVal      int64
+		maxVal      string
+		size        int
+		signed      bool
+		expectedMin bool
+		expectedMax bool
+	}{
+		{name: "unsigned zero min", minVal: 0, maxVal: "255", size: 1, expectedMax: true},
+		{name: "unsigned 8 byte max", minVal: 1, maxVal: "0xFFFFFFFFFFFFFFFF", size: 8, expectedMin: true},
+		{name: "signed full range", minVal: -32768, maxVal: "32767", size: 2, signed: true},
+		{name: "signed partial range", minVal: -40, maxVal: "90", size: 1, signed: true, expectedMin: true, expectedMax: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			maxBig, err := getMinOrMax(tt.maxVal)
+			require.NoError(t, err)
+			minBig, maxBig := dropTypeBounds(big.NewInt(tt.minVal), maxBig, tt.size, tt.signed)
+			require.Equal(t, tt.expectedMin, minBig != nil, "min")
+			require.Equal(t, tt.expectedMax, maxBig != nil, "max")
+		})
+	}
+}
+
+func TestLoadModels(t *testing.T) {
+	t.Parallel()
+	models, err := loadModels(rupschema.OIDCSV())
+	require.NoError(t, err)
+	require.Len(t, models, len(modelIdents))
+	require.Equal(t, Model{Ident: "HCV5LitePro5Lite", Name: "HCV5 Lite / Pro5 Lite"}, models[2])
+
+	require.True(t, Record{Plug5: " yes"}.Supports(models[1]))
+	require.False(t, Record{Plug5: "No", Eco5: "Yes"}.Supports(models[1]))
+	require.False(t, Record{Plug5: "-"}.Supports(models[1]))
+}
+
+// TestDefinitionsMatchOIDTable checks that the declarative conversions of Ruptela OIDs carry the size, sign,
+// multiplier, offset and bounds of the OID table.
+func TestDefinitionsMatchOIDTable(t *testing.T) {
+	t.Parallel()
+	oidMap, err := loadCSVToMap(rupschema.OIDCSV())
+	require.NoError(t, err)
+	for _, definitions := range []string{rupschema.RuptelaDefinitionsYAML(), rupschema.DevStatusDefinitionsYAML()} {
+		defs, err := schema.LoadDefinitionFile(strings.NewReader(definitions))
+		require.NoError(t, err)
+		for _, def := range defs.FromName {
+			for _, conv := range def.Conversions {
+				oid, ok := strings.CutPrefix(conv.OriginalName, "signals.")
+				if !ok || conv.ByteSize == 0 {
+					continue
+				}
+				// The error values column has free text for some OIDs, definitions declare the error values they use.
+				record := oidMap[oid]
+				record.ErrorValues = ""
+				record, err := interpret(record)
+				require.NoError(t, err, "OID %s", oid)
+				require.Equal(t, record.Size, conv.ByteSize, "byteSize of OID %s", oid)
+				require.Equal(t, record.Signed, conv.Signed, "signed of OID %s", oid)
+				multiplier := conv.Multiplier
+				if multiplier == 0 {
+					multiplier = 1
+				}
+				require.InEpsilon(t, record.Multiplier, multiplier, 1e-12, "multiplier of OID %s", oid)
+				require.Equal(t, record.Offset, conv.Offset, "offset of OID %s", oid)
+
+				// Bounds that every value of the byte size satisfies do not need to be declared.
+				bits := uint(conv.ByteSize * 8)
+				typeMin, typeMax := big.NewInt(0), new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits), big.NewInt(2))
+				if conv.Signed {
+					typeMin = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), bits-1))
+					typeMax = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), bits-1), big.NewInt(1))
+				}
+				var expectedMin, expectedMax *int64
+				if record.MinBig != nil && record.MinBig.Cmp(typeMin) > 0 {
+					expectedMin = ptr(record.MinBig.Int64())
+				}
+				if record.MaxBig != nil && record.MaxBig.Cmp(typeMax) < 0 {
+					expectedMax = ptr(record.MaxBig.Int64())
+				}
+				require.Equal(t, expectedMin, conv.RawMin, "rawMin of OID %s", oid)
+				require.Equal(t, expectedMax, conv.RawMax, "rawMax of OID %s", oid)
+			}
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
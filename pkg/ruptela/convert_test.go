@@ -0,0 +1,71 @@
+package ruptela_test
+
+import (
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignedConversion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		raw      string
+		expected float64
+		notFound bool
+	}{
+		{name: "positive", raw: "1E", expected: 30},
+		{name: "negative", raw: "EC", expected: -20},
+		{name: "minimum", raw: "D8", expected: -40},
+		{name: "below minimum", raw: "D7", notFound: true},
+		{name: "above maximum", raw: "5B", notFound: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// OID 6 is the 1 byte signed modem temperature with a range of -40 to 90.
+			val, err := ruptela.ToDIMOAftermarketModemTemperature0(nil, tt.raw)
+			if tt.notFound {
+				require.ErrorIs(t, err, convert.ErrNotFound)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, val)
+		})
+	}
+}
+
+func TestSignedErrorValues(t *testing.T) {
+	t.Parallel()
+	// OID 32 is the 1 byte signed PCB temperature with raw error values 41 and 85.
+	_, err := ruptela.ToDIMOAftermarketPCBTemperature0(nil, "29")
+	require.Error(t, err)
+	val, err := ruptela.ToDIMOAftermarketPCBTemperature0(nil, "F6")
+	require.NoError(t, err)
+	require.Equal(t, -10.0, val)
+}
+
+func TestByteSizeOverflow(t *testing.T) {
+	t.Parallel()
+	// OID 97 and 96 are 1 byte values, a raw value that needs more bytes is not a valid reading.
+	_, err := ruptela.ToExteriorAirTemperature0(nil, "1FF")
+	require.ErrorIs(t, err, convert.ErrNotFound)
+	_, err = ruptela.ToPowertrainCombustionEngineECT0(nil, "1FF")
+	require.ErrorIs(t, err, convert.ErrNotFound)
+	val, err := ruptela.ToExteriorAirTemperature0(nil, "41")
+	require.NoError(t, err)
+	require.Equal(t, 25.0, val)
+}
+
+func TestRawMax(t *testing.T) {
+	t.Parallel()
+	// OID 27 is the GSM signal level with a maximum of 31 in the OID table.
+	_, err := ruptela.ToDIMOAftermarketGSMSignalLevel0(nil, "20")
+	require.ErrorIs(t, err, convert.ErrNotFound)
+	val, err := ruptela.ToDIMOAftermarketGSMSignalLevel0(nil, "1F")
+	require.NoError(t, err)
+	require.Equal(t, 31.0, val)
+}
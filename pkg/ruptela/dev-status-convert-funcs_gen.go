@@ -0,0 +1,78 @@
+// Code generated by github.com/DIMO-Network/model-garage.
+package ruptela
+
+import (
+	"slices"
+
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+)
+
+// This file is automatically populated with conversion functions for each field of the model struct.
+// any conversion functions already defined in this package will be coppied through.
+// note: DO NOT mutate the orginalDoc parameter which is shared between all conversion functions.
+
+// ToDIMOAftermarketGSMSignalLevel0 converts data from field 'signals.27' of type string to 'Vehicle.DIMO.Aftermarket.GSMSignalLevel' of type float64.
+// Vehicle.DIMO.Aftermarket.GSMSignalLevel: Signal level of the aftermarket device's GSM/UMTS connection. 0 = no signal. 31 = strongest signal.
+//
+//	Min: '0' Max: '31'
+func ToDIMOAftermarketGSMSignalLevel0(originalDoc []byte, val string) (float64, error) {
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]uint64{100}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	if rawInt > 31 {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt), nil
+}
+
+// ToDIMOAftermarketModemTemperature0 converts data from field 'signals.6' of type string to 'Vehicle.DIMO.Aftermarket.ModemTemperature' of type float64.
+// Vehicle.DIMO.Aftermarket.ModemTemperature: Temperature of the aftermarket device's cellular modem.
+// Unit: 'celsius'
+func ToDIMOAftermarketModemTemperature0(originalDoc []byte, val string) (float64, error) {
+	rawInt, err := convert.HexInt(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	if rawInt < -40 {
+		return 0, convert.ErrNotFound
+	}
+	if rawInt > 90 {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt), nil
+}
+
+// ToDIMOAftermarketPCBTemperature0 converts data from field 'signals.32' of type string to 'Vehicle.DIMO.Aftermarket.PCBTemperature' of type float64.
+// Vehicle.DIMO.Aftermarket.PCBTemperature: Temperature of the aftermarket device's circuit board.
+// Unit: 'celsius'
+func ToDIMOAftermarketPCBTemperature0(originalDoc []byte, val string) (float64, error) {
+	rawInt, err := convert.HexInt(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]int64{41, 85}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	if rawInt < -40 {
+		return 0, convert.ErrNotFound
+	}
+	if rawInt > 80 {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt), nil
+}
+
+// ToDIMOAftermarketSupplyVoltage0 converts data from field 'signals.29' of type string to 'Vehicle.DIMO.Aftermarket.SupplyVoltage' of type float64.
+// Vehicle.DIMO.Aftermarket.SupplyVoltage: Voltage supplied to the aftermarket device.
+// Unit: 'V'
+func ToDIMOAftermarketSupplyVoltage0(originalDoc []byte, val string) (float64, error) {
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt) / 1000, nil
+}
@@ -0,0 +1,62 @@
+package ruptela
+
+import (
+	"slices"
+)
+
+// Model is a Ruptela hardware model, such as ModelEco5.
+type Model string
+
+// Models returns all known hardware models.
+func Models() []Model {
+	return slices.Clone(models)
+}
+
+// IsKnownModel returns true if the model is in the Ruptela OID table.
+func IsKnownModel(model Model) bool {
+	return slices.Contains(models, model)
+}
+
+// IsSupported returns true if the model can send the OID.
+func IsSupported(model Model, oid uint16) bool {
+	bit, ok := modelBit(model)
+	return ok && oidModels[oid]&bit != 0
+}
+
+// SupportedOIDs returns the OIDs the model can send in ascending order.
+func SupportedOIDs(model Model) []uint16 {
+	bit, ok := modelBit(model)
+	if !ok {
+		return nil
+	}
+	var oids []uint16
+	for oid, mask := range oidModels {
+		if mask&bit != 0 {
+			oids = append(oids, oid)
+		}
+	}
+	slices.Sort(oids)
+	return oids
+}
+
+// SupportedSignals returns the sorted JSON names of the signals the model can produce.
+func SupportedSignals(model Model) []string {
+	if !IsKnownModel(model) {
+		return nil
+	}
+	signals := slices.Clone(commonSignals)
+	for _, oid := range SupportedOIDs(model) {
+		signals = append(signals, oidSignals[oid]...)
+	}
+	slices.Sort(signals)
+	return slices.Compact(signals)
+}
+
+// modelBit returns the bit of the model in oidModels.
+func modelBit(model Model) (uint32, bool) {
+	idx := slices.Index(models, model)
+	if idx < 0 {
+		return 0, false
+	}
+	return 1 << idx, true
+}
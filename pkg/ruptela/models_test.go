@@ -0,0 +1,63 @@
+package ruptela_test
+
+import (
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsSupported(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		model    ruptela.Model
+		oid      uint16
+		expected bool
+	}{
+		{model: ruptela.ModelPlug5, oid: 96, expected: true},
+		{model: ruptela.ModelEco5, oid: 96, expected: false},
+		{model: ruptela.ModelEco5, oid: 2, expected: true},
+		{model: ruptela.ModelPlug5, oid: 2, expected: false},
+		{model: ruptela.ModelPlug5, oid: 9999, expected: false},
+		{model: "Unknown", oid: 29, expected: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(string(tt.model), func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, ruptela.IsSupported(tt.model, tt.oid))
+		})
+	}
+}
+
+func TestSupportedOIDs(t *testing.T) {
+	t.Parallel()
+	require.Len(t, ruptela.Models(), 25)
+	require.True(t, ruptela.IsKnownModel(ruptela.ModelTco3OBD))
+	require.False(t, ruptela.IsKnownModel("Unknown"))
+	require.Nil(t, ruptela.SupportedOIDs("Unknown"))
+
+	oids := ruptela.SupportedOIDs(ruptela.ModelPlug5)
+	require.IsIncreasing(t, oids)
+	require.Contains(t, oids, uint16(96))
+	require.NotContains(t, oids, uint16(2))
+	for _, oid := range oids {
+		require.True(t, ruptela.IsSupported(ruptela.ModelPlug5, oid))
+	}
+}
+
+func TestSupportedSignals(t *testing.T) {
+	t.Parallel()
+	require.Nil(t, ruptela.SupportedSignals("Unknown"))
+
+	plugSignals := ruptela.SupportedSignals(ruptela.ModelPlug5)
+	require.IsIncreasing(t, plugSignals)
+	require.Contains(t, plugSignals, vss.FieldCurrentLocationLatitude)
+	require.Contains(t, plugSignals, vss.FieldOBDDistanceWithMIL)
+
+	// The Eco5 reads no OBD PIDs but still sends its position.
+	ecoSignals := ruptela.SupportedSignals(ruptela.ModelEco5)
+	require.Contains(t, ecoSignals, vss.FieldCurrentLocationLatitude)
+	require.NotContains(t, ecoSignals, vss.FieldOBDDistanceWithMIL)
+}
@@ -0,0 +1,280 @@
+// Package protocol parses the binary Ruptela TCP protocol so that device data can be decoded without a bridge that converts it to JSON.
+package protocol
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+const (
+	// CommandRecords is the command ID of packets with records that use 1 byte IO and event IDs.
+	CommandRecords = 0x01
+	// CommandExtendedRecords is the command ID of packets with records that use 2 byte IO and event IDs.
+	CommandExtendedRecords = 0x44
+	// CommandRecordsAck is the command ID of the server response to a records packet.
+	CommandRecordsAck = 0x64
+
+	// lengthSize is the size of the packet length that precedes the packet data.
+	lengthSize = 2
+	// crcSize is the size of the CRC16 that follows the packet data.
+	crcSize = 2
+)
+
+// ioSizes are the value sizes of the IO element groups in the order they appear in a record.
+var ioSizes = []int{1, 2, 4, 8}
+
+// CRCError is returned when the CRC16 of a packet does not match its data.
+type CRCError struct {
+	Expected uint16
+	Actual   uint16
+}
+
+func (e CRCError) Error() string {
+	return fmt.Sprintf("packet CRC16 is 0x%04X but data has CRC16 0x%04X", e.Expected, e.Actual)
+}
+
+// UnsupportedCommandError is returned for packets with a command that does not carry records.
+type UnsupportedCommandError struct {
+	Command uint8
+}
+
+func (e UnsupportedCommandError) Error() string {
+	return fmt.Sprintf("unsupported command 0x%02X", e.Command)
+}
+
+// Packet is a single records packet sent by a device.
+type Packet struct {
+	// IMEI is the IMEI of the device that sent the packet.
+	IMEI uint64
+	// Command is the command ID of the packet, either CommandRecords or CommandExtendedRecords.
+	Command uint8
+	// RecordsLeft is the number of records still buffered on the device.
+	RecordsLeft uint8
+	Records     []Record
+}
+
+// Record is a single record of a packet.
+type Record struct {
+	Timestamp time.Time
+	// TimestampExtension orders records that share the same timestamp.
+	TimestampExtension uint8
+	// RecordExtension numbers the records that hold the IO elements of a single event in extended records.
+	RecordExtension uint8
+	Priority        uint8
+	GPS             GPS
+	// EventID is the IO ID that triggered the record.
+	EventID uint16
+	IO      []IOElement
+}
+
+// GPS is the GPS header of a record in the units sent by the device.
+type GPS struct {
+	// Longitude in 1e-7 degrees.
+	Longitude int32
+	// Latitude in 1e-7 degrees.
+	Latitude int32
+	// Altitude in decimeters. 0x8000 means the altitude is not available.
+	Altitude uint16
+	// Angle in 0.01 degrees.
+	Angle uint16
+	// Satellites is the number of visible satellites.
+	Satellites uint8
+	// Speed in km/h.
+	Speed uint16
+	// HDOP in 0.1 units.
+	HDOP uint8
+}
+
+// IOElement is the value of a single IO ID, such as an OBD PID read by the device.
+type IOElement struct {
+	ID uint16
+	// Size is the size of the value in bytes, one of 1, 2, 4 or 8.
+	Size  int
+	Value uint64
+}
+
+// Parse parses a complete packet including the leading length and trailing CRC16.
+func Parse(packet []byte) (*Packet, error) {
+	if len(packet) < lengthSize+crcSize {
+		return nil, fmt.Errorf("packet of %d bytes is too short: %w", len(packet), io.ErrUnexpectedEOF)
+	}
+	length := int(binary.BigEndian.Uint16(packet))
+	if len(packet) != lengthSize+length+crcSize {
+		return nil, fmt.Errorf("packet length %d does not match %d bytes of data", length, len(packet)-lengthSize-crcSize)
+	}
+	data := packet[lengthSize : lengthSize+length]
+	expected := binary.BigEndian.Uint16(packet[lengthSize+length:])
+	if actual := CRC16(data); actual != expected {
+		return nil, CRCError{Expected: expected, Actual: actual}
+	}
+	return parseData(data)
+}
+
+// Decode reads and parses the next packet from r, such as a device TCP connection.
+// io.EOF is returned if r has no more packets.
+func Decode(r io.Reader) (*Packet, error) {
+	var lengthBuf [lengthSize]byte
+	if _, err := io.ReadFull(r, lengthBuf[:]); err != nil {
+		return nil, err
+	}
+	length := int(binary.BigEndian.Uint16(lengthBuf[:]))
+	packet := make([]byte, lengthSize+length+crcSize)
+	copy(packet, lengthBuf[:])
+	if _, err := io.ReadFull(r, packet[lengthSize:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("failed to read packet: %w", err)
+	}
+	return Parse(packet)
+}
+
+// Ack returns the response a server sends after receiving a records packet.
+// The device resends the records if they are not accepted.
+func Ack(accepted bool) []byte {
+	data := []byte{CommandRecordsAck, 0}
+	if accepted {
+		data[1] = 1
+	}
+	packet := binary.BigEndian.AppendUint16(nil, uint16(len(data)))
+	packet = append(packet, data...)
+	return binary.BigEndian.AppendUint16(packet, CRC16(data))
+}
+
+// CRC16 returns the CRC-16/KERMIT checksum used by Ruptela packets.
+func CRC16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b)
+		for range 8 {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0x8408
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
+
+// parseData parses the packet data between the length and the CRC16.
+func parseData(data []byte) (*Packet, error) {
+	r := &reader{buf: data}
+	packet := &Packet{
+		IMEI:    r.uint64(),
+		Command: r.uint8(),
+	}
+	if r.err != nil {
+		return nil, fmt.Errorf("failed to read packet header: %w", r.err)
+	}
+	if packet.Command != CommandRecords && packet.Command != CommandExtendedRecords {
+		return nil, UnsupportedCommandError{Command: packet.Command}
+	}
+	extended := packet.Command == CommandExtendedRecords
+	packet.RecordsLeft = r.uint8()
+	count := int(r.uint8())
+	packet.Records = make([]Record, 0, count)
+	for i := range count {
+		record := r.record(extended)
+		if r.err != nil {
+			return nil, fmt.Errorf("failed to read record %d: %w", i, r.err)
+		}
+		packet.Records = append(packet.Records, record)
+	}
+	if r.err != nil {
+		return nil, fmt.Errorf("failed to read records: %w", r.err)
+	}
+	if r.off != len(data) {
+		return nil, fmt.Errorf("packet has %d bytes after the last record", len(data)-r.off)
+	}
+	return packet, nil
+}
+
+// reader reads big-endian values from a packet.
+// After the first short read err is set and all reads return zero.
+type reader struct {
+	buf []byte
+	off int
+	err error
+}
+
+func (r *reader) next(n int) []byte {
+	if r.err != nil {
+		return make([]byte, n)
+	}
+	if len(r.buf)-r.off < n {
+		r.err = io.ErrUnexpectedEOF
+		return make([]byte, n)
+	}
+	b := r.buf[r.off : r.off+n]
+	r.off += n
+	return b
+}
+
+func (r *reader) uint8() uint8 {
+	return r.next(1)[0]
+}
+
+func (r *reader) uint16() uint16 {
+	return binary.BigEndian.Uint16(r.next(2))
+}
+
+func (r *reader) uint32() uint32 {
+	return binary.BigEndian.Uint32(r.next(4))
+}
+
+func (r *reader) uint64() uint64 {
+	return binary.BigEndian.Uint64(r.next(8))
+}
+
+// uint reads an unsigned value of 1, 2, 4 or 8 bytes.
+func (r *reader) uint(size int) uint64 {
+	var value uint64
+	for _, b := range r.next(size) {
+		value = value<<8 | uint64(b)
+	}
+	return value
+}
+
+// id reads an IO or event ID which is 2 bytes in extended records and 1 byte otherwise.
+func (r *reader) id(extended bool) uint16 {
+	if extended {
+		return r.uint16()
+	}
+	return uint16(r.uint8())
+}
+
+func (r *reader) record(extended bool) Record {
+	record := Record{
+		Timestamp:          time.Unix(int64(r.uint32()), 0).UTC(),
+		TimestampExtension: r.uint8(),
+	}
+	if extended {
+		record.RecordExtension = r.uint8()
+	}
+	record.Priority = r.uint8()
+	record.GPS = GPS{
+		Longitude:  int32(r.uint32()),
+		Latitude:   int32(r.uint32()),
+		Altitude:   r.uint16(),
+		Angle:      r.uint16(),
+		Satellites: r.uint8(),
+		Speed:      r.uint16(),
+		HDOP:       r.uint8(),
+	}
+	record.EventID = r.id(extended)
+	for _, size := range ioSizes {
+		count := int(r.uint8())
+		for range count {
+			if r.err != nil {
+				return record
+			}
+			id := r.id(extended)
+			record.IO = append(record.IO, IOElement{ID: id, Size: size, Value: r.uint(size)})
+		}
+	}
+	return record
+}
@@ -0,0 +1,160 @@
+package protocol_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela/protocol"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela/status"
+	"github.com/stretchr/testify/require"
+)
+
+const testIMEI = 868204005544720
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	return data
+}
+
+func TestParseRecords(t *testing.T) {
+	t.Parallel()
+	packet, err := protocol.Parse(readFixture(t, "records.bin"))
+	require.NoError(t, err)
+	require.Equal(t, &protocol.Packet{
+		IMEI:    testIMEI,
+		Command: protocol.CommandRecords,
+		Records: []protocol.Record{
+			{
+				Timestamp: time.Date(2024, 9, 27, 8, 33, 26, 0, time.UTC),
+				GPS: protocol.GPS{
+					Longitude: -9014316, Latitude: 522721466, Altitude: 1048, Angle: 19730, Satellites: 20, Speed: 0, HDOP: 6,
+				},
+				EventID: 7,
+				IO: []protocol.IOElement{
+					{ID: 97, Size: 1, Value: 0x50},
+					{ID: 29, Size: 2, Value: 0x37FF},
+					{ID: 30, Size: 2, Value: 0x1080},
+					{ID: 205, Size: 4, Value: 5},
+					{ID: 104, Size: 8, Value: 0x53414C4C41414146},
+				},
+			},
+		},
+	}, packet)
+}
+
+func TestParseExtendedRecords(t *testing.T) {
+	t.Parallel()
+	packet, err := protocol.Parse(readFixture(t, "extended.bin"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(testIMEI), packet.IMEI)
+	require.Equal(t, uint8(protocol.CommandExtendedRecords), packet.Command)
+	require.Equal(t, uint8(1), packet.RecordsLeft)
+	require.Len(t, packet.Records, 2)
+
+	first := packet.Records[0]
+	require.Equal(t, uint16(409), first.EventID)
+	require.Equal(t, uint8(1), first.Priority)
+	require.Equal(t, []protocol.IOElement{
+		{ID: 409, Size: 1, Value: 1},
+		{ID: 985, Size: 1, Value: 0},
+		{ID: 960, Size: 1, Value: 0x4A},
+		{ID: 29, Size: 2, Value: 0x37FF},
+		{ID: 645, Size: 4, Value: 8},
+		{ID: 104, Size: 8, Value: 0x53414C4C41414146},
+	}, first.IO)
+
+	second := packet.Records[1]
+	require.Equal(t, uint8(1), second.RecordExtension)
+	require.Equal(t, uint16(0x8000), second.GPS.Altitude)
+	require.Equal(t, []protocol.IOElement{{ID: 29, Size: 2, Value: 0x3800}}, second.IO)
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+	valid := readFixture(t, "records.bin")
+
+	corrupt := bytes.Clone(valid)
+	corrupt[20] ^= 0xFF
+	var crcErr protocol.CRCError
+	_, err := protocol.Parse(corrupt)
+	require.ErrorAs(t, err, &crcErr)
+
+	_, err = protocol.Parse(valid[:len(valid)-1])
+	require.Error(t, err, "length mismatch")
+
+	// A valid CRC around a packet with an unknown command.
+	data := []byte{0, 0, 0, 0, 0, 0, 0, 1, 0x10}
+	packet := append([]byte{0, byte(len(data))}, data...)
+	packet = append(packet, byte(protocol.CRC16(data)>>8), byte(protocol.CRC16(data)))
+	var cmdErr protocol.UnsupportedCommandError
+	_, err = protocol.Parse(packet)
+	require.ErrorAs(t, err, &cmdErr)
+	require.Equal(t, uint8(0x10), cmdErr.Command)
+
+	// A valid CRC around a record that is cut short.
+	data = []byte{0, 0, 0, 0, 0, 0, 0, 1, protocol.CommandRecords, 0, 1, 0x66, 0xF6}
+	packet = append([]byte{0, byte(len(data))}, data...)
+	packet = append(packet, byte(protocol.CRC16(data)>>8), byte(protocol.CRC16(data)))
+	_, err = protocol.Parse(packet)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestDecodeStream(t *testing.T) {
+	t.Parallel()
+	stream := bytes.NewReader(append(readFixture(t, "records.bin"), readFixture(t, "extended.bin")...))
+	var commands []uint8
+	for {
+		packet, err := protocol.Decode(stream)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		commands = append(commands, packet.Command)
+	}
+	require.Equal(t, []uint8{protocol.CommandRecords, protocol.CommandExtendedRecords}, commands)
+
+	records := readFixture(t, "records.bin")
+	_, err := protocol.Decode(bytes.NewReader(records[:len(records)-3]))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
+
+func TestCRC16(t *testing.T) {
+	t.Parallel()
+	// CRC-16/KERMIT check value.
+	require.Equal(t, uint16(0x2189), protocol.CRC16([]byte("123456789")))
+	require.Equal(t, []byte{0x00, 0x02, 0x64, 0x01, 0x13, 0xBC}, protocol.Ack(true))
+}
+
+func TestRecordSignals(t *testing.T) {
+	t.Parallel()
+	packet, err := protocol.Parse(readFixture(t, "extended.bin"))
+	require.NoError(t, err)
+	record := packet.Records[0]
+
+	event := record.CloudEvent(cloudevent.CloudEventHeader{
+		ID:      "2pcYwspbaBFJ7NPGZ2kivkuJ12a",
+		Source:  "ruptela/TODO",
+		Subject: "did:nft:1:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_33",
+		Type:    cloudevent.TypeStatus,
+	})
+	require.Equal(t, "37FF", event.Data.Signals["29"])
+	require.Equal(t, "53414C4C41414146", event.Data.Signals["104"])
+	eventJSON, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	expected, err := status.DecodeStatusSignals(eventJSON)
+	require.NoError(t, err)
+	require.NotEmpty(t, expected)
+
+	actual, err := record.Signals(33, "ruptela/TODO")
+	require.NoError(t, err)
+	require.ElementsMatch(t, expected, actual)
+}
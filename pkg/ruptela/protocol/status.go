@@ -0,0 +1,92 @@
+package protocol
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+)
+
+// StatusData is the data of a Ruptela status CloudEvent in the shape read by status.SignalsFromV1Payload.
+type StatusData struct {
+	Pos      Position `json:"pos"`
+	Priority uint8    `json:"prt"`
+	// Signals holds the IO element values as upper case hex strings keyed by their decimal IO ID.
+	Signals map[string]string `json:"signals"`
+	// Trigger is the event ID of the record.
+	Trigger uint16 `json:"trigger"`
+}
+
+// Position is the GPS position of a status payload.
+type Position struct {
+	Alt  uint16 `json:"alt"`
+	Dir  uint16 `json:"dir"`
+	HDOP uint8  `json:"hdop"`
+	Lat  int32  `json:"lat"`
+	Lon  int32  `json:"lon"`
+	Sat  uint8  `json:"sat"`
+	Spd  uint16 `json:"spd"`
+}
+
+// StatusData returns the record in the shape of a status payload.
+func (r *Record) StatusData() StatusData {
+	signals := make(map[string]string, len(r.IO))
+	for _, elem := range r.IO {
+		signals[strconv.FormatUint(uint64(elem.ID), 10)] = strings.ToUpper(strconv.FormatUint(elem.Value, 16))
+	}
+	return StatusData{
+		Pos: Position{
+			Alt:  r.GPS.Altitude,
+			Dir:  r.GPS.Angle,
+			HDOP: r.GPS.HDOP,
+			Lat:  r.GPS.Latitude,
+			Lon:  r.GPS.Longitude,
+			Sat:  r.GPS.Satellites,
+			Spd:  r.GPS.Speed,
+		},
+		Priority: r.Priority,
+		Signals:  signals,
+		Trigger:  r.EventID,
+	}
+}
+
+// CloudEvent returns the record as a status CloudEvent with the given header.
+// The time and data version of the header are set from the record.
+func (r *Record) CloudEvent(hdr cloudevent.CloudEventHeader) cloudevent.CloudEvent[StatusData] {
+	hdr.Time = r.Timestamp
+	hdr.DataVersion = ruptela.StatusEventDS
+	return cloudevent.CloudEvent[StatusData]{
+		CloudEventHeader: hdr,
+		Data:             r.StatusData(),
+	}
+}
+
+// Signals converts the record into signals with the same conversions as status.SignalsFromV1Payload.
+func (r *Record) Signals(tokenID uint32, source string) ([]vss.Signal, error) {
+	jsonData, err := json.Marshal(struct {
+		Data StatusData `json:"data"`
+	}{Data: r.StatusData()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal status data: %w", err)
+	}
+	baseSignal := vss.Signal{
+		TokenID:   tokenID,
+		Timestamp: r.Timestamp,
+		Source:    source,
+	}
+	sigs, errs := ruptela.SignalsFromV1Data(baseSignal, jsonData)
+	if errs != nil {
+		return nil, convert.ConversionError{
+			TokenID:        tokenID,
+			Source:         source,
+			DecodedSignals: sigs,
+			Errors:         errs,
+		}
+	}
+	return sigs, nil
+}
@@ -0,0 +1,4604 @@
+// Code generated by github.com/DIMO-Network/pkg/ruptela/codegen DO NOT EDIT.
+package ruptela
+
+// rawOIDs are the numeric OIDs of the OID table that can be decoded with DecodeRawOID.
+//
+// The following numeric OIDs are not decoded because their row of the OID table could not be interpreted:
+//   - 12: could not parse value: -
+//   - 18: could not parse multiplier: could not parse multiplier: strconv.ParseFloat: parsing "Depends on counter": invalid syntax
+//   - 19: could not parse multiplier: could not parse multiplier: strconv.ParseFloat: parsing "Depends on counter": invalid syntax
+//   - 27: could not parse error value: strconv.ParseUint: parsing "255 and 100": invalid syntax
+//   - 117: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 118: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 119: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 120: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 121: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 252: could not parse value: -
+//   - 470: could not parse value: 1.84E+19
+//   - 480: could not parse value: 1.84E+19
+//   - 490: could not parse error value: strconv.ParseUint: parsing "250 – 255": invalid syntax
+//   - 491: could not parse error value: strconv.ParseUint: parsing "64255 – 65535": invalid syntax
+//   - 492: could not parse multiplier: could not parse numerator: strconv.ParseFloat: parsing "0.125 m": invalid syntax
+//   - 493: could not parse error value: strconv.ParseUint: parsing "64250 – 65535": invalid syntax
+//   - 494: could not parse error value: strconv.ParseUint: parsing "64250 – 65535": invalid syntax
+//   - 496: could not parse error value: strconv.ParseUint: parsing "4211081216 – 4294967295": invalid syntax
+//   - 497: could not parse error value: strconv.ParseUint: parsing "4211081216 – 4294967295": invalid syntax
+//   - 498: could not parse error value: strconv.ParseUint: parsing "4211081215 – 4294967295": invalid syntax
+//   - 499: could not parse error value: strconv.ParseUint: parsing "421108135 – 4294967295": invalid syntax
+//   - 501: could not parse error value: strconv.ParseUint: parsing "0xFFFFFFFF (just ASCII allowed)": invalid syntax
+//   - 502: could not parse error value: strconv.ParseUint: parsing "0xFFFFFFFF (just ASCII allowed)": invalid syntax
+//   - 503: could not parse error value: strconv.ParseUint: parsing "0xFFFFFFFF (just ASCII allowed)": invalid syntax
+//   - 504: could not parse error value: strconv.ParseUint: parsing "0xFFFFFFFF (just ASCII allowed)": invalid syntax
+//   - 505: could not parse error value: strconv.ParseUint: parsing "0xFFFFFFFF (just ASCII allowed)": invalid syntax
+//   - 506: could not parse error value: strconv.ParseUint: parsing "0xFFFFFFFF (just ASCII allowed)": invalid syntax
+//   - 509: could not parse error value: strconv.ParseUint: parsing "0x8000 – probe error": invalid syntax
+//   - 510: could not parse error value: strconv.ParseUint: parsing "0x8000 – probe error": invalid syntax
+//   - 511: could not parse error value: strconv.ParseUint: parsing "0x8000 – probe error": invalid syntax
+//   - 512: could not parse error value: strconv.ParseUint: parsing "0x8000 – probe error": invalid syntax
+//   - 513: could not parse error value: strconv.ParseUint: parsing "0x8000 – probe error": invalid syntax
+//   - 514: could not parse error value: strconv.ParseUint: parsing "0x8000 – probe error": invalid syntax
+//   - 520: could not parse value: -2147483648
+//   - 531: could not parse value: -
+//   - 532: could not parse value: -
+//   - 572: could not parse offset: strconv.ParseFloat: parsing "-160 635": invalid syntax
+//   - 600: could not parse error value: strconv.ParseUint: parsing "0x7FFF Sensor not available\n0x7FFE Abnormality\n0x7FFD No data": invalid syntax
+//   - 601: could not parse error value: strconv.ParseUint: parsing "0x7FFF Sensor not available\n0x7FFE Abnormality\n0x7FFD No data": invalid syntax
+//   - 602: could not parse error value: strconv.ParseUint: parsing "0x7FFF Sensor not available\n0x7FFE Abnormality\n0x7FFD No data": invalid syntax
+//   - 603: could not parse error value: strconv.ParseUint: parsing "0x7FFF Sensor not available\n0x7FFE Abnormality\n0x7FFD No data": invalid syntax
+//   - 604: could not parse error value: strconv.ParseUint: parsing "0x7FFF Sensor not available\n0x7FFE Abnormality\n0x7FFD No data": invalid syntax
+//   - 605: could not parse error value: strconv.ParseUint: parsing "0xFFFF Sensor not available\n0xFFFE Abnormality\n0xFFFD No data": invalid syntax
+//   - 606: could not parse error value: strconv.ParseUint: parsing "0xFFFF Sensor not available\n0xFFFE Abnormality\n0xFFFD No data": invalid syntax
+//   - 607: could not parse error value: strconv.ParseUint: parsing "0xFFFF Sensor not available\n0xFFFE Abnormality\n0xFFFD No data": invalid syntax
+//   - 608: could not parse error value: strconv.ParseUint: parsing "0xFFFF Sensor not available\n0xFFFE Abnormality\n0xFFFD No data": invalid syntax
+//   - 609: could not parse error value: strconv.ParseUint: parsing "0xFFFF Sensor not available\n0xFFFE Abnormality\n0xFFFD No data": invalid syntax
+//   - 631: could not parse error value: strconv.ParseUint: parsing "0xFF DR module not available": invalid syntax
+//   - 684: could not parse error value: strconv.ParseUint: parsing "?": invalid syntax
+//   - 685: could not parse error value: strconv.ParseUint: parsing "?": invalid syntax
+//   - 732: could not parse error value: strconv.ParseUint: parsing "2 = error": invalid syntax
+//   - 733: could not parse multiplier: could not parse multiplier: strconv.ParseFloat: parsing "0.03125. -273": invalid syntax
+//   - 734: could not parse multiplier: could not parse multiplier: strconv.ParseFloat: parsing "0.5. -62.5": invalid syntax
+//   - 853: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 854: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 855: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 856: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 857: could not parse error value: strconv.ParseUint: parsing "0xFFXX": invalid syntax
+//   - 858: could not parse error value: strconv.ParseUint: parsing "0xFFXX": invalid syntax
+//   - 859: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 860: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 861: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 862: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 863: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 864: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 865: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 866: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 867: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 868: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 869: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 889: could not parse error value: strconv.ParseUint: parsing ">0xFAFEXXXX": invalid syntax
+//   - 958: could not parse multiplier: could not parse multiplier: strconv.ParseFloat: parsing "Depends on counter": invalid syntax
+//   - 1146: could not parse error value: strconv.ParseUint: parsing "0xFFFFXXXX": invalid syntax
+//   - 1147: could not parse error value: strconv.ParseUint: parsing "0xFFXX": invalid syntax
+var rawOIDs = map[uint16]RawOID{
+	2: {
+		Name:       "DIN1",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	3: {
+		Name:       "DIN2",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	4: {
+		Name:       "DIN3",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	5: {
+		Name:       "Ignition(DIN4)",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	6: {
+		Name:       "Modem temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		devStatus:  true,
+		validRange: &rawRange{min: 18446744073709551576, max: 90},
+	},
+	10: {
+		Name:       "CAN requests supported",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 3},
+	},
+	11: {
+		Name:       "CAN diagnostics supported",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 3},
+	},
+	13: {
+		Name:       "CAN tacho vehicle motion",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	14: {
+		Name:       "CAN tacho driver 1 time related status",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{14},
+		validRange: &rawRange{min: 0, max: 15},
+	},
+	15: {
+		Name:       "CAN tacho driver 1 card",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	16: {
+		Name:       "CAN tacho driver 2 time related status",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{14},
+		validRange: &rawRange{min: 0, max: 15},
+	},
+	17: {
+		Name:       "CAN tacho driver 2 card",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	20: {
+		Name:       "AIN3",
+		Unit:       "mV",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	21: {
+		Name:       "AIN4",
+		Unit:       "mV",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	22: {
+		Name:       "AIN1",
+		Unit:       "mV",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	23: {
+		Name:       "AIN2",
+		Unit:       "mV",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	24: {
+		Name:       "Tacho card reader state",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	25: {
+		Name:       "Security info",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2},
+		validRange: &rawRange{min: 0, max: 4},
+	},
+	26: {
+		Name:       "AIN1 delta",
+		Unit:       "mV",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+	},
+	28: {
+		Name:       "Current profile",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 1, max: 4},
+	},
+	29: {
+		Name:       "Power supply voltage",
+		Unit:       "mV",
+		Size:       2,
+		Multiplier: 1,
+		status:     true,
+		devStatus:  true,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	30: {
+		Name:       "Battery Voltage",
+		Unit:       "mV",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	31: {
+		Name:       "CAN Engine Braking",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 254},
+	},
+	32: {
+		Name:       "PCB temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		devStatus:  true,
+		errorSet:   []uint64{41, 85},
+		validRange: &rawRange{min: 18446744073709551576, max: 80},
+	},
+	33: {
+		Name:       "AIN2 delta",
+		Unit:       "mV",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+	},
+	35: {
+		Name:       "CAN clutch switch",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	36: {
+		Name:       "CAN brake switch",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	37: {
+		Name:       "CAN cruise control active",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	38: {
+		Name:       "CAN PTO state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{31},
+		validRange: &rawRange{min: 0, max: 5},
+	},
+	39: {
+		Name:       "CAN engine percent load at current speed",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 125},
+	},
+	41: {
+		Name:       "CAN axle1 weight",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 0.5,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	42: {
+		Name:       "CAN system event",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	43: {
+		Name:       "CAN tacho handling information",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	44: {
+		Name:       "CAN tacho direction indicator",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	45: {
+		Name:       "DIN1 hour counter",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	46: {
+		Name:       "DIN2 hour counter",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	47: {
+		Name:       "DIN3 hour counter",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	48: {
+		Name:       "DIN4 hour counter",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	49: {
+		Name:       "Accelerometer X",
+		Unit:       "G",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 0.05,
+	},
+	50: {
+		Name:       "Accelerometer Y",
+		Unit:       "G",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 0.05,
+	},
+	51: {
+		Name:       "Accelerometer Z",
+		Unit:       "G",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 0.05,
+	},
+	52: {
+		Name:       "CAN axle2 weight",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 0.5,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	53: {
+		Name:       "CAN axle3 weight",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 0.5,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	54: {
+		Name:       "CAN axle4 weight",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 0.5,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	55: {
+		Name:       "CAN axle5 weight",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 0.5,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	56: {
+		Name:       "Digital Fuel Sensor C1 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	57: {
+		Name:       "Digital Fuel Sensor C2 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	58: {
+		Name:       "Digital Fuel Sensor C3 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	59: {
+		Name:       "Digital Fuel Sensor C4 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	60: {
+		Name:       "Digital Fuel Sensor C5 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	61: {
+		Name:       "Digital Fuel Sensor C6 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	62: {
+		Name:       "Digital Fuel Sensor C7 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	63: {
+		Name:       "Digital Fuel Sensor C8 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	64: {
+		Name:       "Digital Fuel Sensor C9 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	65: {
+		Name:       "Virtual odometer",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	66: {
+		Name:       "Digital Fuel Sensor C1",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	67: {
+		Name:       "Digital Fuel Sensor B1",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	68: {
+		Name:       "Digital Fuel Sensor C2",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	69: {
+		Name:       "Digital Fuel Sensor C3",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	74: {
+		Name:       "Temperature sensor3",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{2000, 2001, 2002, 2003, 2004, 850},
+		validRange: &rawRange{min: 18446744073709551066, max: 1250},
+	},
+	75: {
+		Name:       "Digital Fuel Sensor C10 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	76: {
+		Name:       "Digital Fuel Sensor B1 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	77: {
+		Name:       "Virtual odometer DIFF",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	78: {
+		Name:       "Temperature sensor0",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{2000, 2001, 2002, 2003, 2004, 850},
+		validRange: &rawRange{min: 18446744073709551066, max: 1250},
+	},
+	79: {
+		Name:       "Temperature sensor1",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{2000, 2001, 2002, 2003, 2004, 850},
+		validRange: &rawRange{min: 18446744073709551066, max: 1250},
+	},
+	80: {
+		Name:       "Temperature sensor2",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{2000, 2001, 2002, 2003, 2004, 850},
+		validRange: &rawRange{min: 18446744073709551066, max: 1250},
+	},
+	81: {
+		Name:       "Digital Fuel Sensor C4",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	82: {
+		Name:       "Digital Fuel Sensor C5",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	83: {
+		Name:       "Digital Fuel Sensor C6",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	84: {
+		Name:       "Digital Fuel Sensor C7",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	85: {
+		Name:       "Digital Fuel Sensor C8",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	86: {
+		Name:       "Digital Fuel Sensor C9",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	87: {
+		Name:       "Digital Fuel Sensor C10",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	88: {
+		Name:       "GSM/UMTS jamming",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	89: {
+		Name:       "CAN ambient air temperature",
+		Unit:       "°C",
+		Size:       2,
+		Multiplier: 0.03125,
+		Offset:     -273,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	90: {
+		Name:       "CAN instantaneous fuel economy",
+		Unit:       "km/L",
+		Size:       2,
+		Multiplier: 0.001953125,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	91: {
+		Name:       "CAN at least one PTO engaged",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	92: {
+		Name:       "CAN high resolution engine total fuel used",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.001,
+		errorRange: &rawRange{min: 4261412864, max: 4278190079},
+		validRange: &rawRange{min: 0, max: 4211081215},
+	},
+	94: {
+		Name:       "OBD RPM",
+		Unit:       "RPM",
+		Size:       2,
+		Multiplier: 0.25,
+		status:     true,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	95: {
+		Name:       "OBD vehicle speed",
+		Unit:       "km/h",
+		Size:       1,
+		Multiplier: 1,
+		status:     true,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	96: {
+		Name:       "OBD engine coolant temperature",
+		Unit:       "°C",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -40,
+		status:     true,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	97: {
+		Name:       "OBD ambient air temperature",
+		Unit:       "°C",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -40,
+		status:     true,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	98: {
+		Name:       "OBD fuel level",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.39215686274509803,
+		status:     true,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	99: {
+		Name:       "OBD fuel type",
+		Size:       1,
+		Multiplier: 1,
+		status:     true,
+	},
+	100: {
+		Name:       "OBD engine fuel rate",
+		Unit:       "L/h",
+		Size:       2,
+		Multiplier: 0.05,
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	101: {
+		Name:       "OBD actual engine percent torque",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -125,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	102: {
+		Name:       "OBD distance traveled while MIL is activated",
+		Unit:       "km",
+		Size:       2,
+		Multiplier: 1,
+		status:     true,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	103: {
+		Name:       "OBD accelerator pedal position",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.39215686274509803,
+		status:     true,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	107: {
+		Name:       "OBD time since engine start",
+		Size:       2,
+		Multiplier: 1,
+		status:     true,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	108: {
+		Name:       "OBD DTC count",
+		Size:       2,
+		Multiplier: 1,
+	},
+	109: {
+		Name:       "Safetybelt staus",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	111: {
+		Name:       "DOUT3 status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	112: {
+		Name:       "DOUT4 status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	113: {
+		Name:       "SD card log",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	114: {
+		Name:       "CAN high resolution total vehicle distance",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 5,
+		status:     true,
+		errorRange: &rawRange{min: 4261412864, max: 4278190079},
+		validRange: &rawRange{min: 0, max: 4211081215},
+	},
+	115: {
+		Name:       "CAN engine coolant temperature",
+		Unit:       "°C",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -40,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	116: {
+		Name:       "CAN fuel rate",
+		Unit:       "L/h",
+		Size:       2,
+		Multiplier: 0.05,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	122: {
+		Name:       "CAN Fuel used delta",
+		Unit:       "l",
+		Size:       2,
+		Multiplier: 0.5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	130: {
+		Name:       "ECO max speed",
+		Unit:       "km/h",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	131: {
+		Name:       "ECO overspeeding timer",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	132: {
+		Name:       "ECO RPM in red band timer",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	133: {
+		Name:       "ECO max RPM",
+		Unit:       "RPM",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	134: {
+		Name:       "ECO brake counter",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	135: {
+		Name:       "ECO extreme and harsh brake counter",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	136: {
+		Name:       "ECO harsh acceleration counter",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	137: {
+		Name:       "ECO idling timer",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	139: {
+		Name:       "ECO engine on timer",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	140: {
+		Name:       "ECO RPM in green band distance",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	141: {
+		Name:       "ECO normal speed distance",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	142: {
+		Name:       "ECO cruise control distance",
+		Unit:       "m",
+		Size:       8,
+		Multiplier: 1,
+	},
+	143: {
+		Name:       "ECO cornering counter",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	144: {
+		Name:       "OT temperature sensor 1",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{5555, 5556},
+	},
+	145: {
+		Name:       "OT temperature sensor 2",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{5555, 5556},
+	},
+	146: {
+		Name:       "OT temperature sensor 3",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{5555, 5556},
+	},
+	147: {
+		Name:       "OT temperature sensor 4",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{5555, 5556},
+	},
+	148: {
+		Name:       "OT temperature sensor 5",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{5555, 5556},
+	},
+	149: {
+		Name:       "OT temperature sensor 6",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{5555, 5556},
+	},
+	150: {
+		Name:       "GSM/UMTS operator",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	159: {
+		Name:       "TCO first driver state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{6},
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	160: {
+		Name:       "TCO second driver state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{6},
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	161: {
+		Name:       "TCO first driver card",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	162: {
+		Name:       "TCO second driver card",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	163: {
+		Name:       "TCO distance",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 5,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	164: {
+		Name:       "TCO trip distance",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 5,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	165: {
+		Name:       "TCO vehicle speed",
+		Unit:       "km/h",
+		Size:       2,
+		Multiplier: 0.00390625,
+		errorSet:   []uint64{64256},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	166: {
+		Name:       "TCO engine speed",
+		Unit:       "RPM",
+		Size:       2,
+		Multiplier: 0.125,
+		errorSet:   []uint64{64256},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	169: {
+		Name:       "ECO idling event",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	170: {
+		Name:       "Battery charge current",
+		Unit:       "mA",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	173: {
+		Name:       "Movement sensor",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	174: {
+		Name:       "Sleep timer",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	175: {
+		Name:       "ECO Absolute idling time",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	176: {
+		Name:       "GPS speed",
+		Unit:       "km/h",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	177: {
+		Name:       "Fridge fuel level",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 127},
+	},
+	178: {
+		Name:       "Fridge battery voltage",
+		Unit:       "V",
+		Size:       2,
+		Multiplier: 0.05,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	179: {
+		Name:       "Fridge total electric hours",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.05,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	180: {
+		Name:       "Fridge total vehicle hours",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.05,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	181: {
+		Name:       "Fridge total engine hours",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.05,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	182: {
+		Name:       "Fridge alarm type",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 15},
+	},
+	183: {
+		Name:       "Fridge alarm code",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	184: {
+		Name:       "Fridge return air temperature",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+	},
+	185: {
+		Name:       "Fridge discharge air temperature",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+	},
+	186: {
+		Name:       "Fridge temperature setpoint",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+	},
+	187: {
+		Name:       "Fridge evaporator coil temperature",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+	},
+	188: {
+		Name:       "Fridge operating mode",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	189: {
+		Name:       "Fridge cycle mode",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	190: {
+		Name:       "Fridge high speed status",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	191: {
+		Name:       "Fridge door status",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	192: {
+		Name:       "Fridge diesel/electric status",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	193: {
+		Name:       "OT digital input 1",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	194: {
+		Name:       "OT digital input 2",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	195: {
+		Name:       "OT digital input 3",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	196: {
+		Name:       "OT digital input 4",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	197: {
+		Name:       "CAN engine speed",
+		Unit:       "RPM",
+		Size:       2,
+		Multiplier: 0.125,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	201: {
+		Name:       "CAN AdBlue level",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	202: {
+		Name:       "CAN J1939 trailer weight",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 2,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	203: {
+		Name:       "CAN engine hours",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.05,
+		errorRange: &rawRange{min: 4261412864, max: 4278190079},
+		validRange: &rawRange{min: 0, max: 4211081215},
+	},
+	204: {
+		Name:       "CAN service distance",
+		Unit:       "km",
+		Size:       2,
+		Multiplier: 5,
+		Offset:     -160635,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	205: {
+		Name:       "CAN Fuel level liters",
+		Unit:       "l",
+		Size:       2,
+		Multiplier: 1,
+		status:     true,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	206: {
+		Name:       "CAN accelerator pedal position 1",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	207: {
+		Name:       "CAN fuel level1",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		status:     true,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	208: {
+		Name:       "CAN engine total fuel used",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.5,
+		errorRange: &rawRange{min: 4261412864, max: 4278190079},
+		validRange: &rawRange{min: 0, max: 4211081215},
+	},
+	209: {
+		Name:       "CAN engine fuel level secondary",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	210: {
+		Name:       "CAN wheel based speed",
+		Unit:       "km/h",
+		Size:       2,
+		Multiplier: 0.00390625,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	211: {
+		Name:       "Digital Fuel Sensor A1",
+		Unit:       "N",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	212: {
+		Name:       "Digital Fuel Sensor A1 temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{128},
+	},
+	213: {
+		Name:       "CAN tachograph vehicle speed",
+		Unit:       "km/h",
+		Size:       2,
+		Multiplier: 0.00390625,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	214: {
+		Name:       "CAN J1939 cargo weight",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 2,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	215: {
+		Name:       "CAN tacho driver1 working state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{6, 7},
+		validRange: &rawRange{min: 0, max: 3},
+	},
+	216: {
+		Name:       "CAN tacho driver2 working state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{6, 7},
+		validRange: &rawRange{min: 0, max: 3},
+	},
+	217: {
+		Name:       "CAN tachograph performance",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	218: {
+		Name:       "CAN tacho vehicle overspeed",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	219: {
+		Name:       "CANBUS Axle location",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 15},
+	},
+	251: {
+		Name:       "Virtual ignition",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	292: {
+		Name:       "TellTale air conditioning",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	293: {
+		Name:       "TellTale high beam",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	294: {
+		Name:       "TellTale low beam dipped beam",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	295: {
+		Name:       "TellTale turn signals",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	296: {
+		Name:       "TellTale hazard warning",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	297: {
+		Name:       "TellTale provision for disabled or handicapped",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	298: {
+		Name:       "TellTale parking brake",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	299: {
+		Name:       "TellTale brake failure/brake system malfunction",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	300: {
+		Name:       "TellTale hatch open",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	301: {
+		Name:       "TellTale fuel level",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	302: {
+		Name:       "TellTale engine coolant temperature TTS",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	303: {
+		Name:       "TellTale battery charging condition",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	304: {
+		Name:       "TellTale engine oil",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	305: {
+		Name:       "TellTale position lights",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	306: {
+		Name:       "TellTale front fog light",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	307: {
+		Name:       "TellTale rear fog light",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	308: {
+		Name:       "TellTale park heating",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	309: {
+		Name:       "TellTale engine",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	310: {
+		Name:       "TellTale service",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	311: {
+		Name:       "TellTale transmission fluid temperature",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	312: {
+		Name:       "TellTale transmission failure/malfunction",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	313: {
+		Name:       "TellTale anti-lock brake system failure",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	314: {
+		Name:       "TellTale worn brake linings",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	315: {
+		Name:       "TellTale washer fluid",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	316: {
+		Name:       "TellTale tire failure/malfunction",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	317: {
+		Name:       "TellTale malfunction/general failure",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	318: {
+		Name:       "TellTale engine oil temperature",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	319: {
+		Name:       "TellTale engine oil level",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	320: {
+		Name:       "TellTale engine coolant level",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	321: {
+		Name:       "TellTale steering fluid level",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	322: {
+		Name:       "TellTale steering failure",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	323: {
+		Name:       "TellTale height control (leveling)",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	324: {
+		Name:       "TellTale retarder",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	325: {
+		Name:       "TellTale engine emission system failure",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	326: {
+		Name:       "TellTale ESP indication",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	327: {
+		Name:       "TellTale brake lights",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	328: {
+		Name:       "TellTale articulation",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	329: {
+		Name:       "TellTale stop request",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	330: {
+		Name:       "TellTale pram request",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	331: {
+		Name:       "TellTale bus stop brake",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	332: {
+		Name:       "TellTale ad blue level",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	333: {
+		Name:       "TellTale raising",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	334: {
+		Name:       "TellTale lowering",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	335: {
+		Name:       "TellTale kneeling",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	336: {
+		Name:       "TellTale engine compartment temperature",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	337: {
+		Name:       "TellTale auxillary air pressure",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	338: {
+		Name:       "TellTale air filter clogged",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	339: {
+		Name:       "TellTale fuel filter differential pressure",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	340: {
+		Name:       "TellTale seat belt",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	341: {
+		Name:       "TellTale EBS",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	342: {
+		Name:       "TellTale lane departure indication",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	343: {
+		Name:       "TellTale advanced emergency braking system",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	344: {
+		Name:       "TellTale ACC",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	345: {
+		Name:       "TellTale trailer connected",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	346: {
+		Name:       "TellTale ABS trailer",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	347: {
+		Name:       "TellTale airbag",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	348: {
+		Name:       "TellTale EBS trailer",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	349: {
+		Name:       "TellTale tachograph indication",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	350: {
+		Name:       "TellTale ESC switched off",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	351: {
+		Name:       "TellTale lane departure warning switched off",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	355: {
+		Name:       "CAN service brake air pressure circuit 1",
+		Unit:       "kPa",
+		Size:       1,
+		Multiplier: 8,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	356: {
+		Name:       "CAN service brake air pressure circuit 2",
+		Unit:       "kPa",
+		Size:       1,
+		Multiplier: 8,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	357: {
+		Name:       "CAN aftertreatment 1 diesel exhaust fluid",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	358: {
+		Name:       "CAN gross combination vehicle weight",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 10,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	359: {
+		Name:       "CAN retarder torque mode",
+		Size:       1,
+		Multiplier: 1,
+	},
+	360: {
+		Name:       "CAN actual retarder percent torque",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -125,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	361: {
+		Name:       "CAN retarder selection non engine",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	362: {
+		Name:       "CAN parking brake switch",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	363: {
+		Name:       "CAN status 2 of doors",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	364: {
+		Name:       "CAN ramp wheel charlift",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	365: {
+		Name:       "CAN position of doors",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{14},
+		validRange: &rawRange{min: 0, max: 15},
+	},
+	366: {
+		Name:       "CAN selected gear",
+		Size:       1,
+		Multiplier: 0,
+		Offset:     -125,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	367: {
+		Name:       "CAN current gear",
+		Size:       1,
+		Multiplier: 0,
+		Offset:     -125,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	368: {
+		Name:       "CAN lock status door 1",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	369: {
+		Name:       "CAN open status door 1",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	370: {
+		Name:       "CAN enable status door 1",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	371: {
+		Name:       "CAN lock status door 2",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	372: {
+		Name:       "CAN open status door 2",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	373: {
+		Name:       "CAN enable status door 2",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	374: {
+		Name:       "CAN lock status door 3",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	375: {
+		Name:       "CAN open status door 3",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	376: {
+		Name:       "CAN enable status door 3",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	377: {
+		Name:       "CAN lock status door 4",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	378: {
+		Name:       "CAN open status door 4",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	379: {
+		Name:       "CAN enable status door 4",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	380: {
+		Name:       "CAN lock status door 5",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	381: {
+		Name:       "CAN open status door 5",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	382: {
+		Name:       "CAN enable status door 5",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	383: {
+		Name:       "CAN lock status door 6",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	384: {
+		Name:       "CAN open status door 6",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	385: {
+		Name:       "CAN enable status door 6",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	386: {
+		Name:       "CAN lock status door 7",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	387: {
+		Name:       "CAN open status door 7",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	388: {
+		Name:       "CAN enable status door 7",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	389: {
+		Name:       "CAN lock status door 8",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	390: {
+		Name:       "CAN open status door 8",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	391: {
+		Name:       "CAN enable status door 8",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	392: {
+		Name:       "CAN lock status door 9",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	393: {
+		Name:       "CAN open status door 9",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	394: {
+		Name:       "CAN enable status door 9",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	395: {
+		Name:       "CAN lock status door 10",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	396: {
+		Name:       "CAN open status door 10",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	397: {
+		Name:       "CAN enable status door 10",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{2, 3},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	402: {
+		Name:       "ECO braking value",
+		Unit:       "m/s^2",
+		Size:       2,
+		Multiplier: 0.01,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	403: {
+		Name:       "ECO acceleration value",
+		Unit:       "m/s^2",
+		Size:       2,
+		Multiplier: 0.01,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	404: {
+		Name:       "ECO cornering value",
+		Unit:       "m/s^2",
+		Size:       2,
+		Multiplier: 0.01,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	405: {
+		Name:       "DOUT1 status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	406: {
+		Name:       "DOUT2 status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	407: {
+		Name:       "OBD CAN fuel consumption",
+		Unit:       "l/100km",
+		Size:       2,
+		Multiplier: 0.01,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	408: {
+		Name:       "OBD CAN fuel used",
+		Unit:       "l",
+		Size:       2,
+		Multiplier: 0.001,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	409: {
+		Name:       "Custom ignition",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	410: {
+		Name:       "Towing alarm",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	411: {
+		Name:       "Roll over alarm",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	412: {
+		Name:       "Sleep state",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	413: {
+		Name:       "Wireless enabled",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	414: {
+		Name:       "UMTS Enabled",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	415: {
+		Name:       "GNSS Antenna",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 2},
+	},
+	416: {
+		Name:       "Wireless pair",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	417: {
+		Name:       "Business / Private",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	418: {
+		Name:       "GPRS Status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	419: {
+		Name:       "GPS altitude",
+		Unit:       "m",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+	},
+	420: {
+		Name:       "ME sound type",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	421: {
+		Name:       "ME dusk time indicator",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	422: {
+		Name:       "ME night time indicator",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	423: {
+		Name:       "ME zero speed",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	424: {
+		Name:       "ME headway valid",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	425: {
+		Name:       "ME headway measurement",
+		Unit:       "s",
+		Size:       1,
+		Multiplier: 0.1,
+		validRange: &rawRange{min: 0, max: 99},
+	},
+	426: {
+		Name:       "ME error valid",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	427: {
+		Name:       "ME error code",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	428: {
+		Name:       "ME LDW off",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	429: {
+		Name:       "ME left LDW on",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	430: {
+		Name:       "ME right LDW on",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	431: {
+		Name:       "ME forward collision warning on",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	432: {
+		Name:       "ME maitenance",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	433: {
+		Name:       "ME failsafe",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	434: {
+		Name:       "ME pedestrians forward collision warning",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	435: {
+		Name:       "ME pedestrians in danger zone",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	436: {
+		Name:       "ME tamper alert",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	437: {
+		Name:       "ME TSR enabled",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	438: {
+		Name:       "ME TSR warning level",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	439: {
+		Name:       "ME headway warning level",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 2},
+	},
+	440: {
+		Name:       "ME headway warning repeat enabled",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	450: {
+		Name:       "ME brake signal",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	451: {
+		Name:       "ME left signal",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	452: {
+		Name:       "ME right signal",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	453: {
+		Name:       "ME wipers",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	454: {
+		Name:       "ME low beam",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	455: {
+		Name:       "ME high beam",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	456: {
+		Name:       "ME wipers available",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	457: {
+		Name:       "ME low beam available",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	458: {
+		Name:       "ME high beam available",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	459: {
+		Name:       "ME speed available",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	460: {
+		Name:       "ME speed",
+		Unit:       "km/h",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	481: {
+		Name:       "CAN Fuel level 2",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	482: {
+		Name:       "CAN Brake Pedal Position",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	483: {
+		Name:       "CAN Fuel type",
+		Size:       1,
+		Multiplier: 1,
+		status:     true,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	484: {
+		Name:       "CAN Total Fuel Used Gaseous",
+		Unit:       "kg",
+		Size:       4,
+		Multiplier: 0.5,
+		errorSet:   []uint64{4261412864},
+		validRange: &rawRange{min: 0, max: 4211081215},
+	},
+	485: {
+		Name:       "CAN Cruise Control States",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{7},
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	486: {
+		Name:       "CAN Hybrid Battery Pack Remaining Charge",
+		Unit:       "%",
+		Size:       2,
+		Multiplier: 0.0025,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	487: {
+		Name:       "CAN Steering Wheel Angle",
+		Unit:       "rad",
+		Size:       2,
+		Multiplier: 0.0009765625,
+		Offset:     -31.374,
+		errorRange: &rawRange{min: 65024, max: 65279},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	488: {
+		Name:       "Spreader Spreading Brine Percentage",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.5,
+		errorSet:   []uint64{201},
+		validRange: &rawRange{min: 0, max: 200},
+	},
+	489: {
+		Name:       "Fridge Engine status (DIN)",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	495: {
+		Name:       "Spreader Maximum-button",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	507: {
+		Name:       "Frequency input 1",
+		Unit:       "Hz",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 1, max: 4000},
+	},
+	508: {
+		Name:       "Frequency input 2",
+		Unit:       "Hz",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 1, max: 4000},
+	},
+	515: {
+		Name:       "CAN EV State of charge % (SOC)",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.5,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	516: {
+		Name:       "CAN EV Distance until recharge",
+		Unit:       "km",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	517: {
+		Name:       "CAN EV Battery charging state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	519: {
+		Name:       "CAN actual engine percent load",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -125,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	521: {
+		Name:       "CAN EV State of charge Wh (SOC)",
+		Unit:       "Wh",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	522: {
+		Name:       "CAN ignition",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	525: {
+		Name:       "Device uptime",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	526: {
+		Name:       "ECO stops counter",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	527: {
+		Name:       "ECO braking distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	528: {
+		Name:       "ECO braking duration",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	529: {
+		Name:       "ECO retarder duration",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	530: {
+		Name:       "CAN fuel level milliliters",
+		Unit:       "ml",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967294},
+	},
+	533: {
+		Name:       "Alcohol Sensor state",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 9},
+	},
+	534: {
+		Name:       "Alcohol Sensor test value",
+		Unit:       "‰; %; mg/L; mg/100ml (based on sensor setup)",
+		Size:       2,
+		Multiplier: 0.001,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	535: {
+		Name:       "Reserved",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	536: {
+		Name:       "iButton passenger ID",
+		Size:       8,
+		Multiplier: 1,
+	},
+	537: {
+		Name:       "Registration alert",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	538: {
+		Name:       "CAN door lock state",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	539: {
+		Name:       "ECO RPM in red band distance",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	540: {
+		Name:       "ECO fuel used while idling",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	541: {
+		Name:       "ECO free rolling distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	542: {
+		Name:       "ECO engine overloaded distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	543: {
+		Name:       "ECO engine overloaded fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	544: {
+		Name:       "ECO overspeeding distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	545: {
+		Name:       "ECO overspeeding fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	546: {
+		Name:       "ECO cruise control on distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	547: {
+		Name:       "ECO cruise control on fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	548: {
+		Name:       "ECO highest gear distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	549: {
+		Name:       "ECO highest gear fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	550: {
+		Name:       "ECO RPM range1 distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	551: {
+		Name:       "ECO RPM range1 fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	552: {
+		Name:       "ECO RPM range2 distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	553: {
+		Name:       "ECO RPM range2 fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	554: {
+		Name:       "ECO RPM range3 distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	555: {
+		Name:       "ECO RPM range3 fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	556: {
+		Name:       "ECO RPM range4 distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	557: {
+		Name:       "ECO RPM range4 fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	558: {
+		Name:       "Panic",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	559: {
+		Name:       "UBI process",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	560: {
+		Name:       "GSM Extended Error Report",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	563: {
+		Name:       "Trailers Braking system wheel–based vehicle speed",
+		Unit:       "km/h",
+		Size:       2,
+		Multiplier: 0.00390625,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	564: {
+		Name:       "Trailers Wheel speed difference main axle",
+		Unit:       "km/h",
+		Size:       2,
+		Multiplier: 0.00390625,
+		Offset:     -125,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	565: {
+		Name:       "Trailers Lateral acceleration",
+		Unit:       "m/s^2",
+		Size:       1,
+		Multiplier: 0.1,
+		Offset:     -12.5,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	566: {
+		Name:       "Trailers Axle load sum",
+		Unit:       "kg",
+		Size:       2,
+		Multiplier: 2,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	567: {
+		Name:       "Trailers Pneumatic supply pressure",
+		Unit:       "kPa",
+		Size:       1,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	571: {
+		Name:       "Trailers High resolution vehicle distance",
+		Unit:       "m",
+		Size:       4,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 4211081215},
+	},
+	575: {
+		Name:       "Wireless Driver ID",
+		Size:       8,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	576: {
+		Name:       "Shock Duration",
+		Unit:       "ms",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 20, max: 5000},
+	},
+	577: {
+		Name:       "DIN1 hours cumulated",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	578: {
+		Name:       "DIN2 hours cumulated",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	579: {
+		Name:       "DIN3 hours cumulated",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	580: {
+		Name:       "DIN4 hours cumulated",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	581: {
+		Name:       "Magnetic  Card ID",
+		Size:       8,
+		Multiplier: 1,
+	},
+	582: {
+		Name:       "Magnetic  Card ID",
+		Size:       8,
+		Multiplier: 1,
+	},
+	583: {
+		Name:       "Trip status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 3},
+	},
+	584: {
+		Name:       "G Peak X",
+		Unit:       "mg",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		validRange: &rawRange{min: 18446744073709547616, max: 4000},
+	},
+	585: {
+		Name:       "G Peak Y",
+		Unit:       "mg",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		validRange: &rawRange{min: 18446744073709547616, max: 4000},
+	},
+	586: {
+		Name:       "G Peak Z",
+		Unit:       "mg",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		validRange: &rawRange{min: 18446744073709547616, max: 4000},
+	},
+	587: {
+		Name:       "CAN engine air intake temperature",
+		Unit:       "°C",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -40,
+		errorSet:   []uint64{254},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	594: {
+		Name:       "CANBUS Electric motor temperature",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		validRange: &rawRange{min: 18446744073709518916, max: 32700},
+	},
+	595: {
+		Name:       "DXP CAN Charger status",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	596: {
+		Name:       "Phone call",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 8},
+	},
+	597: {
+		Name:       "ECO engine braking distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	611: {
+		Name:       "Angle X-axis",
+		Unit:       "°",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		validRange: &rawRange{min: 18446744073709551436, max: 180},
+	},
+	612: {
+		Name:       "Angle Y-axis",
+		Unit:       "°",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		validRange: &rawRange{min: 18446744073709551436, max: 180},
+	},
+	613: {
+		Name:       "Angle Z-axis",
+		Unit:       "°",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		validRange: &rawRange{min: 18446744073709551436, max: 180},
+	},
+	618: {
+		Name:       "Satellite Sent messages",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	619: {
+		Name:       "Satellite Pending messages",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 9},
+	},
+	627: {
+		Name:       "DOUT activation by speed",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 5},
+	},
+	628: {
+		Name:       "DOUT activation by AIN voltage",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4},
+	},
+	629: {
+		Name:       "DOUT activation by DIN level",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 5},
+	},
+	630: {
+		Name:       "MCR Driver registration status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	632: {
+		Name:       "MCR Card status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	637: {
+		Name:       "DOUT activation by GSM jamming",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4},
+	},
+	638: {
+		Name:       "KL_Instant engine motor current",
+		Unit:       "A",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	639: {
+		Name:       "KL_Main battery voltage",
+		Unit:       "V",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	640: {
+		Name:       "KL_Main battery current",
+		Unit:       "A",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+		validRange: &rawRange{min: 0, max: 9223372036854775807},
+	},
+	641: {
+		Name:       "KL_Battery Charge current",
+		Unit:       "A",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	642: {
+		Name:       "OBD Fuel Level, L",
+		Unit:       "l",
+		Size:       2,
+		Multiplier: 1,
+		status:     true,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	644: {
+		Name:       "OBD Manufacturer",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	645: {
+		Name:       "OBD Odometer, Km",
+		Unit:       "km",
+		Size:       4,
+		Multiplier: 1,
+		status:     true,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	651: {
+		Name:       "Geozone group",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 8},
+	},
+	652: {
+		Name:       "PortA camera snaphot on SD card",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	653: {
+		Name:       "PortB camera snaphot on SD card",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	654: {
+		Name:       "PortA camera snaphot",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	655: {
+		Name:       "PortB camera snaphot",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	656: {
+		Name:       "Kimax total trailer weight",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	657: {
+		Name:       "Kimax total truck weight",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	658: {
+		Name:       "iQFreeze Compartment 1 temperature",
+		Unit:       "°C",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	661: {
+		Name:       "iQFreeze Compartment 1 setpoint",
+		Unit:       "°C",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	664: {
+		Name:       "iQFreeze Ambient air temperature",
+		Unit:       "°C",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	665: {
+		Name:       "iQFreeze Engine coolant temperature",
+		Unit:       "°C",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	666: {
+		Name:       "iQFreeze RPM",
+		Unit:       "rpm",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	667: {
+		Name:       "iQFreeze Compartment 1 cycle mode",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	670: {
+		Name:       "iQFreeze Compartment 1 operating mode",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	673: {
+		Name:       "iQFreeze Compartment 1 door state",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	676: {
+		Name:       "iQFreeze Battery voltage",
+		Unit:       "V",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	678: {
+		Name:       "iQFreeze Total electric hours",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	679: {
+		Name:       "iQFreeze Total engine hours",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	680: {
+		Name:       "iQFreeze Total vehicle hours",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	683: {
+		Name:       "iQFreeze Errors quantity",
+		Size:       4,
+		Multiplier: 0.1,
+		Offset:     -250,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	693: {
+		Name:       "iQFreeze Refrigerator type",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	695: {
+		Name:       "iQFreeze DIN1",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	698: {
+		Name:       "iQFreeze ADC1",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	699: {
+		Name:       "iQFreeze ADC2",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	700: {
+		Name:       "iQFreeze ADC3",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	705: {
+		Name:       "iQFreeze ADC1 error",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	706: {
+		Name:       "iQFreeze ADC2 error",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	707: {
+		Name:       "iQFreeze ADC3 error",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	716: {
+		Name:       "Fatigue sensor alert",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	717: {
+		Name:       "Last snapshot info",
+		Size:       8,
+		Multiplier: 1,
+	},
+	718: {
+		Name:       "Fatigue sensor snapshot on SD card",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	719: {
+		Name:       "DOUT activation by Rollover",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 3},
+	},
+	720: {
+		Name:       "CAN EV Battery2 State of charge % (SOC)",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.5,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	721: {
+		Name:       "CAN EV Battery2 charging state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	722: {
+		Name:       "OBD EV State of charge % (SOC)",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		status:     true,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	723: {
+		Name:       "OBD EV Distance until recharge",
+		Unit:       "km",
+		Size:       2,
+		Multiplier: 1,
+		status:     true,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	724: {
+		Name:       "CAN Total idle fuel used",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.5,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	725: {
+		Name:       "CAN Total idle hours",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.05,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	726: {
+		Name:       "CAN Fuel temperature",
+		Unit:       "°C",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -40,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	727: {
+		Name:       "CAN Engine oil temperature",
+		Unit:       "°C",
+		Size:       2,
+		Multiplier: 0.03125,
+		Offset:     -273,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	728: {
+		Name:       "CAN Engine oil pressure",
+		Unit:       "kPa",
+		Size:       1,
+		Multiplier: 4,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	729: {
+		Name:       "CAN Average fuel economy",
+		Unit:       "km/L",
+		Size:       2,
+		Multiplier: 0.001953125,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	730: {
+		Name:       "CAN Boost pressure",
+		Unit:       "kPa",
+		Size:       1,
+		Multiplier: 2,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	731: {
+		Name:       "CAN Intake manifold temperature",
+		Unit:       "°C",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -40,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	738: {
+		Name:       "MCR Card Swipe",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	739: {
+		Name:       "Autogeofence alert",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	754: {
+		Name:       "OBD CAN total fuel used",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.001,
+		errorRange: &rawRange{min: 4261412864, max: 4278190079},
+		validRange: &rawRange{min: 0, max: 4211081215},
+	},
+	755: {
+		Name:       "Accelerometer avg X",
+		Unit:       "G",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 0.05,
+		validRange: &rawRange{min: 18446744073709551489, max: 9223372036854775807},
+	},
+	756: {
+		Name:       "Accelerometer avg Y",
+		Unit:       "G",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 0.05,
+		validRange: &rawRange{min: 18446744073709551489, max: 9223372036854775807},
+	},
+	757: {
+		Name:       "Accelerometer avg Z",
+		Unit:       "G",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 0.05,
+		validRange: &rawRange{min: 18446744073709551489, max: 9223372036854775807},
+	},
+	758: {
+		Name:       "Tampering detection",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	759: {
+		Name:       "GNSS antenna used",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	762: {
+		Name:       "Network Technology",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	763: {
+		Name:       "Network registration status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	764: {
+		Name:       "Beacon 0 battery",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	765: {
+		Name:       "Beacon 1 battery",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	766: {
+		Name:       "Beacon 2 battery",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	767: {
+		Name:       "Beacon 3 battery",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	768: {
+		Name:       "Beacon 4 battery",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	769: {
+		Name:       "Beacon 0 battery voltage",
+		Unit:       "V",
+		Size:       1,
+		Multiplier: 0.1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	770: {
+		Name:       "Beacon 1 battery voltage",
+		Unit:       "V",
+		Size:       1,
+		Multiplier: 0.1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	771: {
+		Name:       "Beacon 2 battery voltage",
+		Unit:       "V",
+		Size:       1,
+		Multiplier: 0.1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	772: {
+		Name:       "Beacon 3 battery voltage",
+		Unit:       "V",
+		Size:       1,
+		Multiplier: 0.1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	773: {
+		Name:       "Beacon 4 battery voltage",
+		Unit:       "V",
+		Size:       1,
+		Multiplier: 0.1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	774: {
+		Name:       "Beacon 0 state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	775: {
+		Name:       "Beacon 1 state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	776: {
+		Name:       "Beacon 2 state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	777: {
+		Name:       "Beacon 3 state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	778: {
+		Name:       "Beacon 4 state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	779: {
+		Name:       "Beacon 0 sensor",
+		Unit:       "kPa/°",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	780: {
+		Name:       "Beacon 1 sensor",
+		Unit:       "kPa/°",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	781: {
+		Name:       "Beacon 2 sensor",
+		Unit:       "kPa/°",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	782: {
+		Name:       "Beacon 3 sensor",
+		Unit:       "kPa/°",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	783: {
+		Name:       "Beacon 4 sensor",
+		Unit:       "kPa/°",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	784: {
+		Name:       "Beacon 0 Frequency",
+		Unit:       "Hz",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	785: {
+		Name:       "Beacon 1 Frequency",
+		Unit:       "Hz",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	786: {
+		Name:       "Beacon 2 Frequency",
+		Unit:       "Hz",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	787: {
+		Name:       "Beacon 3 Frequency",
+		Unit:       "Hz",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	788: {
+		Name:       "Beacon 4 Frequency",
+		Unit:       "Hz",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	789: {
+		Name:       "Beacon 0 additional info",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	790: {
+		Name:       "Beacon 1 additional info",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	791: {
+		Name:       "Beacon 2 additional info",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	792: {
+		Name:       "Beacon 3 additional info",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	793: {
+		Name:       "Beacon 4 additional info",
+		Unit:       "l",
+		Size:       4,
+		Multiplier: 0.001,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	794: {
+		Name:       "Beacon 0 DTC",
+		Size:       8,
+		Multiplier: 1,
+	},
+	795: {
+		Name:       "Beacon 1 DTC",
+		Size:       8,
+		Multiplier: 1,
+	},
+	796: {
+		Name:       "Beacon 2 DTC",
+		Size:       8,
+		Multiplier: 1,
+	},
+	797: {
+		Name:       "Beacon 3 DTC",
+		Size:       8,
+		Multiplier: 1,
+	},
+	798: {
+		Name:       "Beacon 4 DTC",
+		Size:       8,
+		Multiplier: 1,
+	},
+	799: {
+		Name:       "Beacon 0 angle",
+		Size:       8,
+		Multiplier: 1,
+	},
+	800: {
+		Name:       "Beacon 1 angle",
+		Size:       8,
+		Multiplier: 1,
+	},
+	801: {
+		Name:       "Beacon 2 angle",
+		Size:       8,
+		Multiplier: 1,
+	},
+	802: {
+		Name:       "Beacon 3 angle",
+		Size:       8,
+		Multiplier: 1,
+	},
+	803: {
+		Name:       "Beacon 4 angle",
+		Size:       8,
+		Multiplier: 1,
+	},
+	804: {
+		Name:       "Tolerable acceleration event",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	805: {
+		Name:       "Accident reconstruction data",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	806: {
+		Name:       "Trip type",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	817: {
+		Name:       "TH sensor 0 humidity",
+		Unit:       "% RH",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{2000, 2001, 2002, 2003, 2004},
+		validRange: &rawRange{min: 0, max: 1000},
+	},
+	818: {
+		Name:       "TH sensor 1 humidity",
+		Unit:       "% RH",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{2000, 2001, 2002, 2003, 2004},
+		validRange: &rawRange{min: 0, max: 1000},
+	},
+	819: {
+		Name:       "TH sensor 2 humidity",
+		Unit:       "% RH",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{2000, 2001, 2002, 2003, 2004},
+		validRange: &rawRange{min: 0, max: 1000},
+	},
+	820: {
+		Name:       "TH sensor 3 humidity",
+		Unit:       "% RH",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{2000, 2001, 2002, 2003, 2004},
+		validRange: &rawRange{min: 0, max: 1000},
+	},
+	821: {
+		Name:       "Low frequency input 1",
+		Unit:       "Hz",
+		Size:       2,
+		Multiplier: 0.01,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	822: {
+		Name:       "Low frequency input 2",
+		Unit:       "Hz",
+		Size:       2,
+		Multiplier: 0.01,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	826: {
+		Name:       "Spreader Brush Working Position",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 2},
+	},
+	829: {
+		Name:       "Kimax trailer load",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	830: {
+		Name:       "Kimax truck load",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	831: {
+		Name:       "Kimax axle weight 1",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	832: {
+		Name:       "Kimax axle weight 2",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	833: {
+		Name:       "Kimax axle weight 3",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	834: {
+		Name:       "Kimax axle weight 4",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	835: {
+		Name:       "Kimax axle weight 5",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	836: {
+		Name:       "Kimax axle weight 6",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	837: {
+		Name:       "Kimax axle weight 7",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	838: {
+		Name:       "Kimax axle weight 8",
+		Unit:       "t",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 999},
+	},
+	839: {
+		Name:       "Harness type",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 3},
+	},
+	840: {
+		Name:       "TK CAN Fridge coolant temperature",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+	},
+	841: {
+		Name:       "TK CAN Fridge engine RPM",
+		Unit:       "RPM",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	842: {
+		Name:       "TK CAN Fridge Ambient temperature",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+		validRange: &rawRange{min: 9223372036854775808, max: 32766},
+	},
+	843: {
+		Name:       "TK CAN Fridge zone temperature 2",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+		validRange: &rawRange{min: 9223372036854775808, max: 32766},
+	},
+	844: {
+		Name:       "TK CAN Fridge zone temperature 3",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+		validRange: &rawRange{min: 9223372036854775808, max: 32766},
+	},
+	846: {
+		Name:       "TK CAN Fridge alarm count",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 254},
+	},
+	849: {
+		Name:       "Autoconfiguration status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	851: {
+		Name:       "LAC",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	852: {
+		Name:       "Cell ID",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967294},
+	},
+	874: {
+		Name:       "TCO_CAN Duration of next daily rest period",
+		Unit:       "min",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	875: {
+		Name:       "TCO_CAN Duration of next weekly rest period",
+		Unit:       "min",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	886: {
+		Name:       "Fridge operating mode 2",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	887: {
+		Name:       "Fridge discharge air temperature 2",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+		validRange: &rawRange{min: 9223372036854775808, max: 32766},
+	},
+	888: {
+		Name:       "Fridge temperature setpoint 2",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+		validRange: &rawRange{min: 9223372036854775808, max: 32766},
+	},
+	890: {
+		Name:       "Security alarm",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 2},
+	},
+	891: {
+		Name:       "ECO free rolling with CC distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	892: {
+		Name:       "ECO free rolling with CC fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	893: {
+		Name:       "ECO free rolling w/o CC distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	894: {
+		Name:       "ECO free rolling w/o CC fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	895: {
+		Name:       "ECO retarder distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	896: {
+		Name:       "ECO retarder distance w/o CC",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	897: {
+		Name:       "ECO cruise control timer",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	898: {
+		Name:       "ECO CC+TPS fuel used",
+		Unit:       "ml",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	899: {
+		Name:       "ECO CC+TPS distance",
+		Unit:       "m",
+		Size:       2,
+		Multiplier: 5,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	900: {
+		Name:       "ECO CC+TPS timer",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	901: {
+		Name:       "ECO Parking brake abuse",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	902: {
+		Name:       "Fridge operating mode 3",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 7},
+	},
+	903: {
+		Name:       "Fridge discharge air temperature 3",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+		validRange: &rawRange{min: 9223372036854775808, max: 32766},
+	},
+	904: {
+		Name:       "Fridge temperature setpoint 3",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 0.1,
+		errorSet:   []uint64{32767},
+		validRange: &rawRange{min: 9223372036854775808, max: 32766},
+	},
+	905: {
+		Name:       "Axl Calibration State",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 254},
+	},
+	907: {
+		Name:       "CAN Heated Mirrors",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	908: {
+		Name:       "CAN Heated Seats Row1",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	909: {
+		Name:       "CAN Heated Seats Row2",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	910: {
+		Name:       "CAN Heated Seats Row3",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	911: {
+		Name:       "CAN Heated Windscreen",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	912: {
+		Name:       "CAN Climate Control (HVAC)",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	913: {
+		Name:       "CAN EV cooling/heating pump",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	914: {
+		Name:       "CAN EV Coolant Heater",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	915: {
+		Name:       "CAN EV Average energy Consumption",
+		Unit:       "kWh/100 km",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{0},
+		validRange: &rawRange{min: 0, max: 64255},
+	},
+	916: {
+		Name:       "AIN3 delta",
+		Unit:       "mV",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{32767},
+	},
+	917: {
+		Name:       "AIN4 delta",
+		Unit:       "mV",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		errorSet:   []uint64{32767},
+	},
+	918: {
+		Name:       "ECO min RPM",
+		Unit:       "RPM",
+		Size:       2,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	920: {
+		Name:       "Vehicle RPM",
+		Unit:       "RPM",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	921: {
+		Name:       "Vehicle speed",
+		Unit:       "km/h",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	922: {
+		Name:       "Vehicle Mileage (ODO)",
+		Unit:       "km",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	923: {
+		Name:       "Vehicle Fuel Level %",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	924: {
+		Name:       "Vehicle Fuel Level L",
+		Unit:       "L",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	925: {
+		Name:       "Vehicle Fuel Rate (l/h)",
+		Unit:       "L/h",
+		Size:       2,
+		Multiplier: 0.05,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	926: {
+		Name:       "Vehicle Engine coolant temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		Offset:     -40,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 9223372036854775807},
+	},
+	927: {
+		Name:       "Vehicle Ambient air temperature",
+		Unit:       "°C",
+		Size:       2,
+		Signed:     true,
+		Multiplier: 1,
+		Offset:     -40,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 9223372036854775807},
+	},
+	928: {
+		Name:       "Accelerator Pedal position",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	929: {
+		Name:       "Electric Vehicle State of Charge (SOC) %",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	930: {
+		Name:       "Electric Vehicle Distance Until Recharge",
+		Unit:       "km",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	934: {
+		Name:       "YUTONG Average Power Consumption",
+		Unit:       "kWh/km",
+		Size:       1,
+		Multiplier: 0.01,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	935: {
+		Name:       "YUTONG Motor Power Percentage",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 1,
+		Offset:     -100,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 100},
+	},
+	936: {
+		Name:       "YUTONG EV Battery Voltage",
+		Unit:       "V",
+		Size:       2,
+		Multiplier: 0.1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	937: {
+		Name:       "YUTONG EV Battery Current",
+		Unit:       "A",
+		Size:       2,
+		Multiplier: 0.05,
+		Offset:     -1200,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+	948: {
+		Name:       "ATH state",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	949: {
+		Name:       "CAN EV State of health (SOH)",
+		Unit:       "%",
+		Size:       2,
+		Multiplier: 0.01,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 10000},
+	},
+	950: {
+		Name:       "OBD EV State of health (SOH)",
+		Unit:       "%",
+		Size:       2,
+		Multiplier: 0.01,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 10000},
+	},
+	956: {
+		Name:       "Traction hourmeter",
+		Unit:       "h",
+		Size:       4,
+		Multiplier: 0.05,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967294},
+	},
+	957: {
+		Name:       "External supply current",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	959: {
+		Name:       "OBD driver seatbelt;",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	960: {
+		Name:       "OBD tire pressure front left",
+		Unit:       "PSI",
+		Size:       2,
+		Multiplier: 0.05,
+		status:     true,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	961: {
+		Name:       "OBD tire pressure front right",
+		Unit:       "PSI",
+		Size:       2,
+		Multiplier: 0.05,
+		status:     true,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	962: {
+		Name:       "OBD tire pressure rear left",
+		Unit:       "PSI",
+		Size:       2,
+		Multiplier: 0.05,
+		status:     true,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	963: {
+		Name:       "OBD tire pressure rear righ",
+		Unit:       "PSI",
+		Size:       2,
+		Multiplier: 0.05,
+		status:     true,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	964: {
+		Name:       "OBD Oil Life remaining",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.393,
+		status:     true,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 254},
+	},
+	965: {
+		Name:       "CAN tire pressure front left",
+		Unit:       "PSI",
+		Size:       2,
+		Multiplier: 0.05,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	966: {
+		Name:       "CAN tire pressure front right",
+		Unit:       "PSI",
+		Size:       2,
+		Multiplier: 0.05,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	967: {
+		Name:       "CAN tire pressure rear left",
+		Unit:       "PSI",
+		Size:       2,
+		Multiplier: 0.05,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	968: {
+		Name:       "CAN tire pressure rear right",
+		Unit:       "PSI",
+		Size:       2,
+		Multiplier: 0.05,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	974: {
+		Name:       "Wi-Fi connected devices",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 16},
+	},
+	978: {
+		Name:       "AutoConfiguration Status",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	979: {
+		Name:       "Overall Energy Consumed from Trolley ",
+		Unit:       "kWh",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967294},
+	},
+	980: {
+		Name:       "Overall Energy Returned to Trolley",
+		Unit:       "kWh",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967294},
+	},
+	985: {
+		Name:       "Unplug Detection",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	986: {
+		Name:       "Driver registration engine on time",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 64800},
+	},
+	987: {
+		Name:       "Driver registration rest time",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 64800},
+	},
+	988: {
+		Name:       "DIN5",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	989: {
+		Name:       "DIN6",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 1},
+	},
+	990: {
+		Name:       "DIN5 hour counter",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	991: {
+		Name:       "DIN6 hour counter",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	992: {
+		Name:       "DIN5 hours cumulated",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	993: {
+		Name:       "DIN6 hours cumulated",
+		Unit:       "s",
+		Size:       4,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	994: {
+		Name:       "Wake up source",
+		Size:       1,
+		Multiplier: 1,
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	996: {
+		Name:       "Power supply voltage - Ext. range",
+		Unit:       "mV",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	997: {
+		Name:       "AIN1 - Ext. range",
+		Unit:       "mV",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	998: {
+		Name:       "AIN2 - Ext. range",
+		Unit:       "mV",
+		Size:       4,
+		Multiplier: 1,
+		errorSet:   []uint64{4294967295},
+		validRange: &rawRange{min: 0, max: 4294967295},
+	},
+	999: {
+		Name:       "Current LTE band",
+		Size:       1,
+		Multiplier: 1,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 255},
+	},
+	1000: {
+		Name:       "MCU temperature",
+		Unit:       "°C",
+		Size:       1,
+		Signed:     true,
+		Multiplier: 1,
+		Offset:     -40,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 18446744073709551576, max: 90},
+	},
+	1148: {
+		Name:       "OBD AdBlue level, l",
+		Unit:       "liters",
+		Size:       1,
+		Multiplier: 1,
+		status:     true,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 254},
+	},
+	1149: {
+		Name:       "CAN AdBlue level, l",
+		Unit:       "liters",
+		Size:       1,
+		Multiplier: 1,
+		status:     true,
+		errorSet:   []uint64{255},
+		validRange: &rawRange{min: 0, max: 254},
+	},
+	1150: {
+		Name:       "OBD AdBlue level, %",
+		Unit:       "%",
+		Size:       1,
+		Multiplier: 0.4,
+		status:     true,
+		errorSet:   []uint64{251},
+		validRange: &rawRange{min: 0, max: 250},
+	},
+	1152: {
+		Name:       "Distance left until refuel ",
+		Unit:       "km",
+		Size:       2,
+		Multiplier: -1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65534},
+	},
+	5005: {
+		Name:       "Time to GNSS fix",
+		Unit:       "s",
+		Size:       2,
+		Multiplier: 1,
+		errorSet:   []uint64{65535},
+		validRange: &rawRange{min: 0, max: 65535},
+	},
+}
@@ -0,0 +1,143 @@
+package ruptela
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/tidwall/gjson"
+)
+
+// RawSignalPrefix is the name prefix of signals that hold the decoded value of an OID that is not converted to a VSS signal.
+const RawSignalPrefix = "ruptela.raw."
+
+// RawOID describes how the value of an OID is decoded with the Ruptela OID table.
+type RawOID struct {
+	// Name is the name of the OID in the OID table.
+	Name string
+	// Unit is the unit of the decoded value, empty if the value has no unit.
+	Unit string
+	// Size is the size of the raw value in bytes.
+	Size int
+	// Signed is true if the raw value is a two's complement signed integer.
+	Signed     bool
+	Multiplier float64
+	Offset     float64
+
+	// status is true if the OID is converted by SignalsFromV1Data.
+	status bool
+	// devStatus is true if the OID is converted by SignalsFromDevStatusData.
+	devStatus  bool
+	errorSet   []uint64
+	errorRange *rawRange
+	// validRange holds the raw bits of the min and max values of the OID.
+	validRange *rawRange
+}
+
+// rawRange is an inclusive range of raw values.
+type rawRange struct {
+	min uint64
+	max uint64
+}
+
+const bitsInByte = 8
+
+// LookupRawOID returns the OID table entry used to decode the OID.
+// Only numeric OIDs are in the table, bitmaps and strings are not decoded.
+func LookupRawOID(oid uint16) (RawOID, bool) {
+	info, ok := rawOIDs[oid]
+	return info, ok
+}
+
+// DecodeRawOID decodes the hex value of an OID with the multiplier, offset and error values of the OID table.
+// convert.ErrNotFound is returned if the value is an error value or outside the min and max of the OID.
+func DecodeRawOID(oid uint16, rawValue string) (float64, error) {
+	info, ok := rawOIDs[oid]
+	if !ok {
+		return 0, fmt.Errorf("OID %d is not a numeric OID", oid)
+	}
+	rawUint, err := strconv.ParseUint(rawValue, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse uint: %w", err)
+	}
+	bits := uint(info.Size * bitsInByte)
+	if !info.Signed && rawUint == uint64(1)<<bits-1 {
+		return 0, errNotFound
+	}
+	if slices.Contains(info.errorSet, rawUint) {
+		return 0, errNotFound
+	}
+	if info.errorRange != nil && info.errorRange.min <= rawUint && rawUint <= info.errorRange.max {
+		return 0, errNotFound
+	}
+
+	if info.Signed {
+		// Sign extend the two's complement value to 64 bits.
+		rawInt := int64(rawUint<<(64-bits)) >> (64 - bits)
+		if info.validRange != nil && (rawInt < int64(info.validRange.min) || rawInt > int64(info.validRange.max)) {
+			return 0, errNotFound
+		}
+		return float64(rawInt)*info.Multiplier + info.Offset, nil
+	}
+	if info.validRange != nil && (rawUint < info.validRange.min || rawUint > info.validRange.max) {
+		return 0, errNotFound
+	}
+	return float64(rawUint)*info.Multiplier + info.Offset, nil
+}
+
+// RawSignalsFromV1Data creates a RawSignalPrefix signal for each numeric OID of the v1 status JSON data that SignalsFromV1Data does not convert.
+// Keys with an index suffix such as "525_1" are decoded as their OID and keep the suffix in the signal name.
+// The signals carry the unit of the OID table, if the OID has one.
+// On error, partial results may be returned.
+func RawSignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []error) {
+	return rawSignalsFromData(baseSignal, jsonData, func(info RawOID) bool { return info.status })
+}
+
+// RawSignalsFromDevStatusData creates a RawSignalPrefix signal for each numeric OID of the device status JSON data
+// that SignalsFromDevStatusData does not convert, like RawSignalsFromV1Data.
+// On error, partial results may be returned.
+func RawSignalsFromDevStatusData(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []error) {
+	return rawSignalsFromData(baseSignal, jsonData, func(info RawOID) bool { return info.devStatus })
+}
+
+// rawSignalsFromData decodes the numeric OIDs of the data.signals object, skipping the OIDs that are converted to VSS signals.
+// Indexed keys of converted OIDs are still decoded since the conversions only read the key without a suffix.
+func rawSignalsFromData(baseSignal vss.Signal, jsonData []byte, converted func(RawOID) bool) ([]vss.Signal, []error) {
+	var retSignals []vss.Signal
+	var errs []error
+	gjson.GetBytes(jsonData, "data.signals").ForEach(func(key, value gjson.Result) bool {
+		oidStr, _, indexed := strings.Cut(key.String(), "_")
+		oid, err := strconv.ParseUint(oidStr, 10, 16)
+		if err != nil {
+			return true
+		}
+		info, ok := rawOIDs[uint16(oid)]
+		if !ok || (converted(info) && !indexed) {
+			return true
+		}
+		if value.Type != gjson.String {
+			errs = append(errs, fmt.Errorf("failed to get raw OID '%s': value is not a string", key.String()))
+			return true
+		}
+		val, err := DecodeRawOID(uint16(oid), value.Str)
+		if err != nil {
+			if !errors.Is(err, errNotFound) {
+				errs = append(errs, fmt.Errorf("failed to get raw OID '%s': %w", key.String(), err))
+			}
+			return true
+		}
+		retSignals = append(retSignals, vss.Signal{
+			Name:        RawSignalPrefix + key.String(),
+			TokenID:     baseSignal.TokenID,
+			Timestamp:   baseSignal.Timestamp,
+			Source:      baseSignal.Source,
+			ValueNumber: val,
+			Unit:        info.Unit,
+		})
+		return true
+	})
+	return retSignals, errs
+}
@@ -0,0 +1,99 @@
+package ruptela_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRawOID(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		oid      uint16
+		rawValue string
+		expected float64
+		notFound bool
+		wantErr  bool
+	}{
+		{name: "unsigned", oid: 30, rawValue: "1080", expected: 4224},
+		{name: "unsigned error value", oid: 30, rawValue: "FFFF", notFound: true},
+		{name: "signed multiplier", oid: 49, rawValue: "FE", expected: -0.1},
+		{name: "offset", oid: 96, rawValue: "50", expected: 40},
+		{name: "error range", oid: 754, rawValue: "FE000000", notFound: true},
+		{name: "above max", oid: 754, rawValue: "FB000000", notFound: true},
+		{name: "signed below min", oid: 6, rawValue: "D7", notFound: true},
+		{name: "bitmap", oid: 1, rawValue: "1", wantErr: true},
+		{name: "invalid hex", oid: 30, rawValue: "XYZ", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			val, err := ruptela.DecodeRawOID(tt.oid, tt.rawValue)
+			switch {
+			case tt.notFound:
+				require.ErrorIs(t, err, convert.ErrNotFound)
+			case tt.wantErr:
+				require.Error(t, err)
+				require.NotErrorIs(t, err, convert.ErrNotFound)
+			default:
+				require.NoError(t, err)
+				require.InDelta(t, tt.expected, val, 1e-9)
+			}
+		})
+	}
+}
+
+func TestLookupRawOID(t *testing.T) {
+	t.Parallel()
+	info, ok := ruptela.LookupRawOID(96)
+	require.True(t, ok)
+	require.Equal(t, "OBD engine coolant temperature", info.Name)
+	require.Equal(t, "°C", info.Unit)
+	require.Equal(t, -40.0, info.Offset)
+
+	_, ok = ruptela.LookupRawOID(1)
+	require.False(t, ok, "bitmap OIDs are not decoded")
+}
+
+func TestRawSignalsFromV1Data(t *testing.T) {
+	t.Parallel()
+	baseSignal := vss.Signal{TokenID: 33, Timestamp: time.Date(2024, 9, 27, 8, 33, 26, 0, time.UTC), Source: "ruptela/TODO"}
+	jsonData := []byte(`{"data": {"signals": {"29": "37FF", "30": "1080", "49": "FE", "96": "FF", "96_1": "50", "9999": "1", "51": 7}}}`)
+	sigs, errs := ruptela.RawSignalsFromV1Data(baseSignal, jsonData)
+	require.Len(t, errs, 1, "non string values are errors")
+
+	rawSignal := func(name string, val float64, unit string) vss.Signal {
+		sig := baseSignal
+		sig.Name = ruptela.RawSignalPrefix + name
+		sig.ValueNumber = val
+		sig.Unit = unit
+		return sig
+	}
+	// 29 and 96 are converted to VSS signals but the indexed 96_1 is not.
+	require.ElementsMatch(t, []vss.Signal{
+		rawSignal("30", 4224, "mV"),
+		rawSignal("49", -0.1, "G"),
+		rawSignal("96_1", 40, "°C"),
+	}, sigs)
+}
+
+func TestRawSignalsFromDevStatusData(t *testing.T) {
+	t.Parallel()
+	baseSignal := vss.Signal{TokenID: 42, Timestamp: time.Date(2024, 9, 27, 8, 33, 26, 0, time.UTC), Source: "ruptela/TODO"}
+	jsonData := []byte(`{"data": {"signals": {"29": "37FF", "30": "1080", "96": "50"}}}`)
+	sigs, errs := ruptela.RawSignalsFromDevStatusData(baseSignal, jsonData)
+	require.Empty(t, errs)
+
+	// 29 is converted by the device status definitions, 96 only by the status definitions.
+	names := make([]string, len(sigs))
+	for i, sig := range sigs {
+		names[i] = sig.Name
+	}
+	require.ElementsMatch(t, []string{ruptela.RawSignalPrefix + "30", ruptela.RawSignalPrefix + "96"}, names)
+}
@@ -9,6 +9,9 @@ var oidCSV string
 //go:embed ruptela-definitions.yaml
 var ruptelaDefinitions string
 
+//go:embed dev-status-definitions.yaml
+var devStatusDefinitions string
+
 // OIDCSV is the embedded CSV file containing ruptela OID definitions.
 func OIDCSV() string {
 	return oidCSV
@@ -18,3 +21,8 @@ func OIDCSV() string {
 func RuptelaDefinitionsYAML() string {
 	return ruptelaDefinitions
 }
+
+// DevStatusDefinitionsYAML is the embedded YAML file containing the dev-status-definitions.yaml for the VSS schema.
+func DevStatusDefinitionsYAML() string {
+	return devStatusDefinitions
+}
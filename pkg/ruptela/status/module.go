@@ -0,0 +1,96 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/modules"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela/fingerprint"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// ModuleName is the name the Ruptela module is registered under.
+const ModuleName = "ruptela"
+
+func init() {
+	modules.Register(ModuleName, &Module{}, ruptela.StatusEventDS, ruptela.LocationEventDS, ruptela.DevStatusDS)
+}
+
+// ModuleConfig holds the optional settings of the Ruptela module.
+type ModuleConfig struct {
+	// RawPassthrough also returns the numeric OIDs that are not converted to VSS signals, see WithRawPassthrough.
+	// Location messages have no OIDs and are decoded without it.
+	RawPassthrough bool `json:"rawPassthrough"`
+}
+
+// Module is the Ruptela source module.
+// A Module must not be copied after first use.
+type Module struct {
+	config ModuleConfig
+	mu     sync.RWMutex
+}
+
+// SetConfig sets the module configuration from a JSON encoded ModuleConfig.
+// It is safe to call while the module is converting messages.
+func (m *Module) SetConfig(config string) error {
+	var cfg ModuleConfig
+	if err := json.Unmarshal([]byte(config), &cfg); err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.config = cfg
+	return nil
+}
+
+// SignalConvert converts a Ruptela status, location or device status CloudEvent into a slice of signals.
+func (m *Module) SignalConvert(_ context.Context, msgData []byte) ([]vss.Signal, error) {
+	m.mu.RLock()
+	cfg := m.config
+	m.mu.RUnlock()
+	var opts []Option
+	if cfg.RawPassthrough && gjson.GetBytes(msgData, "dataversion").String() != ruptela.LocationEventDS {
+		opts = append(opts, WithRawPassthrough())
+	}
+	return DecodeStatusSignals(msgData, opts...)
+}
+
+// FingerprintConvert converts a Ruptela CloudEvent into a fingerprint.
+func (m *Module) FingerprintConvert(_ context.Context, msgData []byte) (cloudevent.Fingerprint, error) {
+	event, err := fingerprint.DecodeFingerprint(msgData)
+	if err != nil {
+		return cloudevent.Fingerprint{}, err
+	}
+	return event.Data, nil
+}
+
+// EventConvert is not supported by Ruptela.
+func (m *Module) EventConvert(context.Context, []byte) ([]vss.Event, error) {
+	return nil, modules.UnsupportedError{Module: ModuleName, Operation: modules.OperationEvents}
+}
+
+// CloudEventConvert returns the message unchanged since Ruptela messages are already CloudEvents.
+// Device status messages describe the device itself so their subject is set to the producer, like AutoPi device status events.
+func (m *Module) CloudEventConvert(_ context.Context, msgData []byte) ([][]byte, error) {
+	if !json.Valid(msgData) {
+		return nil, fmt.Errorf("message is not valid JSON")
+	}
+	if gjson.GetBytes(msgData, "dataversion").String() != ruptela.DevStatusDS {
+		return [][]byte{msgData}, nil
+	}
+	producer := gjson.GetBytes(msgData, "producer")
+	if producer.Type != gjson.String || producer.Str == "" {
+		return nil, fmt.Errorf("device status message is missing a producer")
+	}
+	msgData, err := sjson.SetBytes(msgData, "subject", producer.Str)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set device status subject: %w", err)
+	}
+	return [][]byte{msgData}, nil
+}
@@ -0,0 +1,87 @@
+package status_test
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/modules"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela/status"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+)
+
+func TestModuleDecode(t *testing.T) {
+	t.Parallel()
+	name, _, err := modules.Lookup("ruptela/TODO", ruptela.LocationEventDS)
+	require.NoError(t, err)
+	require.Equal(t, status.ModuleName, name)
+
+	decoded, err := modules.Decode(context.Background(), []byte(`{
+		"subject": "did:nft:1:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_33",
+		"source": "ruptela/TODO",
+		"type": "dimo.status",
+		"dataversion": "r/v0/loc",
+		"time": "2024-09-26T14:19:14Z",
+		"data": {"location": [{"alt": 1232, "hdop": 0, "lat": 432699983, "lon": -715014200, "ts": 1727360340}]}
+	}`))
+	require.NoError(t, err)
+	require.Equal(t, status.ModuleName, decoded.Module)
+	require.Len(t, decoded.Signals, 4)
+}
+
+func TestModuleCloudEventConvertDevStatus(t *testing.T) {
+	t.Parallel()
+	events, err := (&status.Module{}).CloudEventConvert(context.Background(), []byte(devStatusInputJSON))
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "did:nft:1:0x9c94C395cBcBDe662235E0A9d3bB87Ad708561BA_42", gjson.GetBytes(events[0], "subject").String())
+
+	_, err = (&status.Module{}).CloudEventConvert(context.Background(), []byte(`{"dataversion":"r/v0/dev","subject":"did:nft:1:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_33"}`))
+	require.Error(t, err)
+}
+
+func TestModuleRawPassthroughConfig(t *testing.T) {
+	t.Parallel()
+	registry := modules.NewRegistry()
+	require.NoError(t, registry.Register(status.ModuleName, &status.Module{}, ruptela.StatusEventDS))
+	event := []byte(`{
+		"subject": "did:nft:1:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_33",
+		"source": "ruptela/TODO",
+		"type": "dimo.status",
+		"dataversion": "r/v0/s",
+		"time": "2024-09-27T08:33:26Z",
+		"data": {"signals": {"29": "37FF", "30": "1080"}}
+	}`)
+	isRaw := func(sig vss.Signal) bool { return strings.HasPrefix(sig.Name, ruptela.RawSignalPrefix) }
+
+	decoded, err := registry.Decode(context.Background(), event)
+	require.NoError(t, err)
+	require.False(t, slices.ContainsFunc(decoded.Signals, isRaw))
+
+	require.NoError(t, registry.SetModuleConfig(status.ModuleName, `{"rawPassthrough":true}`))
+	decoded, err = registry.Decode(context.Background(), event)
+	require.NoError(t, err)
+	require.Contains(t, decoded.Signals, vss.Signal{TokenID: 33, Timestamp: ts, Name: ruptela.RawSignalPrefix + "30", ValueNumber: 4224, Source: "ruptela/TODO", Unit: "mV"})
+
+	require.Error(t, registry.SetModuleConfig(status.ModuleName, `not json`))
+}
+
+func TestModuleSetConfigWhileConverting(t *testing.T) {
+	t.Parallel()
+	module := &status.Module{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 100 {
+			_ = module.SetConfig(`{"rawPassthrough":true}`)
+		}
+	}()
+	for range 100 {
+		_, _ = module.SignalConvert(context.Background(), []byte(devStatusInputJSON))
+	}
+	<-done
+}
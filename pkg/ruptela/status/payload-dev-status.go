@@ -0,0 +1,75 @@
+package status
+
+import (
+	"fmt"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/tidwall/gjson"
+)
+
+// SignalsFromDevStatusPayload gets a slice of signals from a device status payload.
+// Device status signals describe the Ruptela device itself so the signals use the token ID of the producer.
+func SignalsFromDevStatusPayload(jsonData []byte, opts ...Option) ([]vss.Signal, error) {
+	var cfg options
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	ts, err := TimestampFromV1Data(jsonData)
+	if err != nil {
+		return nil, convert.ConversionError{
+			Errors: []error{fmt.Errorf("error getting timestamp: %w", err)},
+		}
+	}
+	tokenID, err := DeviceTokenIDFromData(jsonData)
+	if err != nil {
+		return nil, convert.ConversionError{
+			Errors: []error{fmt.Errorf("error getting device tokenId: %w", err)},
+		}
+	}
+	source, err := SourceFromData(jsonData)
+	if err != nil {
+		return nil, convert.ConversionError{
+			TokenID: tokenID,
+			Errors:  []error{fmt.Errorf("error getting source: %w", err)},
+		}
+	}
+
+	baseSignal := vss.Signal{
+		TokenID:   tokenID,
+		Timestamp: ts,
+		Source:    source,
+	}
+	sigs, errs := ruptela.SignalsFromDevStatusData(baseSignal, jsonData)
+	sigs, errs = cfg.apply(baseSignal, jsonData, sigs, errs, ruptela.RawSignalsFromDevStatusData)
+	if errs != nil {
+		return nil, convert.ConversionError{
+			TokenID:        tokenID,
+			Source:         source,
+			DecodedSignals: sigs,
+			Errors:         errs,
+		}
+	}
+	return sigs, nil
+}
+
+// DeviceTokenIDFromData gets the token ID of the device from the producer of a payload.
+func DeviceTokenIDFromData(jsonData []byte) (uint32, error) {
+	lookupKey := "producer"
+	producer := gjson.GetBytes(jsonData, lookupKey)
+	if !producer.Exists() {
+		return 0, convert.FieldNotFoundError{Field: "tokenID", Lookup: lookupKey}
+	}
+	producerStr, ok := producer.Value().(string)
+	if !ok {
+		return 0, fmt.Errorf("%s field is not a string", lookupKey)
+	}
+	tokenID, err := cloudevent.TokenIDFromDID(producerStr)
+	if err != nil {
+		return 0, fmt.Errorf("error decoding producer: %w", err)
+	}
+	return tokenID, nil
+}
@@ -0,0 +1,59 @@
+package status_test
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela/status"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+)
+
+const devStatusInputJSON = `
+{
+	"source": "ruptela/TODO",
+	"producer": "did:nft:1:0x9c94C395cBcBDe662235E0A9d3bB87Ad708561BA_42",
+	"subject": "did:nft:1:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_33",
+	"dataversion": "r/v0/dev",
+	"time": "2024-09-27T08:33:26Z",
+	"data": {
+		"signals": {
+			"6": "1E",
+			"27": "14",
+			"29": "37FF",
+			"32": "19",
+			"30": "1080"
+		}
+	}
+}`
+
+func TestDevStatusPayload(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2024, 9, 27, 8, 33, 26, 0, time.UTC)
+	expectedSignals := []vss.Signal{
+		{TokenID: 42, Timestamp: ts, Name: vss.FieldDIMOAftermarketGSMSignalLevel, ValueNumber: 20, ValueInt: 20, Source: "ruptela/TODO"},
+		{TokenID: 42, Timestamp: ts, Name: vss.FieldDIMOAftermarketModemTemperature, ValueNumber: 30, Source: "ruptela/TODO"},
+		{TokenID: 42, Timestamp: ts, Name: vss.FieldDIMOAftermarketPCBTemperature, ValueNumber: 25, Source: "ruptela/TODO"},
+		{TokenID: 42, Timestamp: ts, Name: vss.FieldDIMOAftermarketSupplyVoltage, ValueNumber: 14.335, Source: "ruptela/TODO"},
+	}
+
+	actualSignals, err := status.DecodeStatusSignals([]byte(devStatusInputJSON))
+	require.NoError(t, err)
+	slices.SortFunc(actualSignals, func(a, b vss.Signal) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	require.Equal(t, expectedSignals, actualSignals)
+
+	// OIDs that are not converted are passed through with the unit of the OID table.
+	rawSignals, err := status.DecodeStatusSignals([]byte(devStatusInputJSON), status.WithRawPassthrough())
+	require.NoError(t, err)
+	require.Subset(t, rawSignals, expectedSignals)
+	require.Len(t, rawSignals, len(expectedSignals)+1)
+	require.Contains(t, rawSignals, vss.Signal{TokenID: 42, Timestamp: ts, Name: ruptela.RawSignalPrefix + "30", ValueNumber: 4224, Source: "ruptela/TODO", Unit: "mV"})
+
+	_, err = status.SignalsFromDevStatusPayload([]byte(`{"source":"ruptela/TODO","time":"2024-09-27T08:33:26Z","data":{"signals":{"6":"1E"}}}`))
+	require.Error(t, err, "device status without a producer")
+}
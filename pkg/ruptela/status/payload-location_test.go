@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
 	"github.com/DIMO-Network/model-garage/pkg/ruptela/status"
 	"github.com/DIMO-Network/model-garage/pkg/vss"
 	"github.com/stretchr/testify/require"
@@ -26,8 +27,17 @@ func TestLocationPayload(t *testing.T) {
 	require.Equal(t, expected, actualSignals, "converted vehicle does not match expected vehicle")
 }
 
+func TestLocationPayloadOptions(t *testing.T) {
+	t.Parallel()
+	event := `{"dataversion":"` + ruptela.LocationEventDS + `","subject":"did:nft:1:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_33","source":"ruptela/TODO","data":{"location":[]}}`
+	_, err := status.DecodeStatusSignals([]byte(event))
+	require.NoError(t, err)
+	_, err = status.DecodeStatusSignals([]byte(event), status.WithRawPassthrough())
+	require.Error(t, err, "location messages have no OIDs to pass through")
+}
+
 func expectedLocationSignals() []vss.Signal {
-	ts = time.Unix(1727360340, 0).UTC()
+	ts := time.Unix(1727360340, 0).UTC()
 	return []vss.Signal{
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldCurrentLocationAltitude, ValueNumber: 123.2, Source: "ruptela/TODO"},
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldCurrentLocationLatitude, ValueNumber: 43.2699983, Source: "ruptela/TODO"},
@@ -3,14 +3,60 @@ package status
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/DIMO-Network/model-garage/pkg/convert"
 	"github.com/DIMO-Network/model-garage/pkg/ruptela"
 	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/tidwall/gjson"
 )
 
+// Option configures how a payload is decoded.
+type Option func(*options)
+
+type options struct {
+	rawPassthrough bool
+	model          ruptela.Model
+}
+
+// UnexpectedOIDsError is reported in a convert.ConversionError when a payload has OIDs that its hardware model does not support.
+// The signals of the payload are still decoded.
+type UnexpectedOIDsError struct {
+	Model ruptela.Model
+	OIDs  []string
+}
+
+// Error returns the error message.
+func (e UnexpectedOIDsError) Error() string {
+	return fmt.Sprintf("model '%s' does not support OIDs %s", e.Model, strings.Join(e.OIDs, ", "))
+}
+
+// WithRawPassthrough also returns the numeric OIDs of status and device status payloads that are not converted to VSS signals.
+// They are named ruptela.RawSignalPrefix followed by their key, decoded with their OID table multiplier and offset
+// and carry the unit of the OID table.
+func WithRawPassthrough() Option {
+	return func(o *options) {
+		o.rawPassthrough = true
+	}
+}
+
+// WithModel checks the OIDs of status and device status payloads against the given hardware model, see UnexpectedOIDs.
+// OIDs the model does not support are reported as an UnexpectedOIDsError in the returned convert.ConversionError.
+func WithModel(model ruptela.Model) Option {
+	return func(o *options) {
+		o.model = model
+	}
+}
+
 // SignalsFromV1Payload gets a slice signals from a v1 payload.
-func SignalsFromV1Payload(jsonData []byte) ([]vss.Signal, error) {
+func SignalsFromV1Payload(jsonData []byte, opts ...Option) ([]vss.Signal, error) {
+	var cfg options
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	ts, err := TimestampFromV1Data(jsonData)
 	if err != nil {
 		return nil, convert.ConversionError{
@@ -37,6 +83,7 @@ func SignalsFromV1Payload(jsonData []byte) ([]vss.Signal, error) {
 		Source:    source,
 	}
 	sigs, errs := ruptela.SignalsFromV1Data(baseSignal, jsonData)
+	sigs, errs = cfg.apply(baseSignal, jsonData, sigs, errs, ruptela.RawSignalsFromV1Data)
 	if errs != nil {
 		return nil, convert.ConversionError{
 			TokenID:        tokenID,
@@ -47,3 +94,46 @@ func SignalsFromV1Payload(jsonData []byte) ([]vss.Signal, error) {
 	}
 	return sigs, nil
 }
+
+// apply adds the raw signals and unexpected OID errors of the options to the converted signals and errors of a payload.
+func (o options) apply(baseSignal vss.Signal, jsonData []byte, sigs []vss.Signal, errs []error,
+	rawSignals func(vss.Signal, []byte) ([]vss.Signal, []error),
+) ([]vss.Signal, []error) {
+	if o.rawPassthrough {
+		rawSigs, rawErrs := rawSignals(baseSignal, jsonData)
+		sigs = append(sigs, rawSigs...)
+		errs = append(errs, rawErrs...)
+	}
+	if o.model != "" {
+		unexpected, err := UnexpectedOIDs(o.model, jsonData)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error checking OIDs: %w", err))
+		} else if len(unexpected) != 0 {
+			errs = append(errs, UnexpectedOIDsError{Model: o.model, OIDs: unexpected})
+		}
+	}
+	return sigs, errs
+}
+
+// UnexpectedOIDs returns the sorted signal keys of a v1 payload that the given hardware model does not support.
+// Keys with an index suffix such as "525_1" are checked by their OID.
+func UnexpectedOIDs(model ruptela.Model, jsonData []byte) ([]string, error) {
+	if !ruptela.IsKnownModel(model) {
+		return nil, fmt.Errorf("unknown Ruptela model '%s'", model)
+	}
+	result := gjson.GetBytes(jsonData, "data.signals")
+	if !result.Exists() {
+		return nil, convert.FieldNotFoundError{Field: "signals", Lookup: "data.signals"}
+	}
+	var unexpected []string
+	result.ForEach(func(key, _ gjson.Result) bool {
+		oidStr, _, _ := strings.Cut(key.String(), "_")
+		oid, err := strconv.ParseUint(oidStr, 10, 16)
+		if err != nil || !ruptela.IsSupported(model, uint16(oid)) {
+			unexpected = append(unexpected, key.String())
+		}
+		return true
+	})
+	slices.Sort(unexpected)
+	return unexpected, nil
+}
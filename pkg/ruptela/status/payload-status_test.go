@@ -2,10 +2,14 @@ package status_test
 
 import (
 	"cmp"
+	"fmt"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+	"github.com/DIMO-Network/model-garage/pkg/ruptela"
 	"github.com/DIMO-Network/model-garage/pkg/ruptela/status"
 	"github.com/DIMO-Network/model-garage/pkg/vss"
 	"github.com/stretchr/testify/require"
@@ -100,11 +104,118 @@ var (
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldCurrentLocationLongitude, ValueNumber: -0.9014316, Source: "ruptela/TODO"},
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldDIMOAftermarketNSAT, ValueNumber: 20, Source: "ruptela/TODO"},
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldPowertrainType, ValueString: "COMBUSTION", Source: "ruptela/TODO"},
-		{TokenID: 33, Timestamp: ts, Name: vss.FieldPowertrainFuelSystemRelativeLevel, ValueNumber: 2, Source: "ruptela/TODO"},
+		{TokenID: 33, Timestamp: ts, Name: vss.FieldPowertrainFuelSystemRelativeLevel, ValueNumber: 2, ValueInt: 2, Source: "ruptela/TODO"},
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldOBDDistanceWithMIL, ValueNumber: 0, Source: "ruptela/TODO"},
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldPowertrainCombustionEngineTPS, ValueNumber: 0, Source: "ruptela/TODO"},
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldPowertrainTransmissionTravelledDistance, ValueNumber: 8, Source: "ruptela/TODO"},
 		{TokenID: 33, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 0, Source: "ruptela/TODO"},
-		{TokenID: 33, Timestamp: ts, Name: vss.FieldPowertrainTractionBatteryRange, ValueNumber: 59.97, Source: "ruptela/TODO"},
+		{TokenID: 33, Timestamp: ts, Name: vss.FieldPowertrainTractionBatteryRange, ValueNumber: 59970000, ValueInt: 59970000, Source: "ruptela/TODO"},
 	}
 )
+
+func TestRawPassthrough(t *testing.T) {
+	t.Parallel()
+	isRaw := func(sig vss.Signal) bool { return strings.HasPrefix(sig.Name, ruptela.RawSignalPrefix) }
+
+	defaultSignals, err := status.SignalsFromV1Payload([]byte(fullInputJSON))
+	require.NoError(t, err)
+	require.False(t, slices.ContainsFunc(defaultSignals, isRaw))
+
+	rawSignals, err := status.SignalsFromV1Payload([]byte(fullInputJSON), status.WithRawPassthrough())
+	require.NoError(t, err)
+	require.Subset(t, rawSignals, defaultSignals)
+	require.Contains(t, rawSignals, vss.Signal{TokenID: 33, Timestamp: ts, Name: ruptela.RawSignalPrefix + "30", ValueNumber: 4224, Source: "ruptela/TODO", Unit: "mV"})
+	require.Contains(t, rawSignals, vss.Signal{TokenID: 33, Timestamp: ts, Name: ruptela.RawSignalPrefix + "525_1", ValueNumber: 0xA502A, Source: "ruptela/TODO", Unit: "s"})
+	for _, sig := range rawSignals {
+		// OIDs converted to VSS signals are not passed through.
+		require.NotEqual(t, ruptela.RawSignalPrefix+"29", sig.Name)
+	}
+}
+
+func TestWithModel(t *testing.T) {
+	t.Parallel()
+	defaultSignals, err := status.SignalsFromV1Payload([]byte(fullInputJSON))
+	require.NoError(t, err)
+
+	_, err = status.SignalsFromV1Payload([]byte(fullInputJSON), status.WithModel(ruptela.ModelPlug5))
+	var convErr convert.ConversionError
+	require.ErrorAs(t, err, &convErr)
+	require.Equal(t, defaultSignals, convErr.DecodedSignals)
+	require.Equal(t, []error{status.UnexpectedOIDsError{Model: ruptela.ModelPlug5, OIDs: []string{"5060"}}}, convErr.Errors)
+
+	_, err = status.SignalsFromV1Payload([]byte(fullInputJSON), status.WithModel("Unknown"))
+	require.ErrorAs(t, err, &convErr)
+	require.Len(t, convErr.Errors, 1)
+}
+
+func TestTokenIDFromData(t *testing.T) {
+	t.Parallel()
+	tokenID, err := status.TokenIDFromData([]byte(`{"subject":"did:erc721:137:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF:33"}`))
+	require.NoError(t, err)
+	require.Equal(t, uint32(33), tokenID)
+
+	_, err = status.TokenIDFromData([]byte(`{"subject":"did:vin:1HGCM82633A004352"}`))
+	require.Error(t, err)
+}
+
+func TestUnexpectedOIDs(t *testing.T) {
+	t.Parallel()
+	input := []byte(`{"data": {"signals": {"2": "1", "96": "FF", "525_1": "A502A", "9999": "0"}}}`)
+	tests := []struct {
+		model    ruptela.Model
+		expected []string
+	}{
+		{model: ruptela.ModelPlug5, expected: []string{"2", "9999"}},
+		{model: ruptela.ModelEco5, expected: []string{"96", "9999"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(string(tt.model), func(t *testing.T) {
+			t.Parallel()
+			unexpected, err := status.UnexpectedOIDs(tt.model, input)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, unexpected)
+		})
+	}
+
+	_, err := status.UnexpectedOIDs("Unknown", input)
+	require.Error(t, err)
+	_, err = status.UnexpectedOIDs(ruptela.ModelPlug5, []byte(`{"data": {}}`))
+	require.Error(t, err)
+}
+
+func TestDecodeStatusSignalsBatch(t *testing.T) {
+	t.Parallel()
+	event := `{
+		"source": "ruptela/TODO",
+		"dataversion": "r/v0/s",
+		"subject": "did:nft:1:0xbA5738a18d83D41847dfFbDC6101d37C69c9B0cF_33",
+		"time": "2024-09-27T08:33:26Z",
+		"data": {"signals": {"29": "37FF", "95": "%s"}}
+	}`
+	voltage := vss.Signal{TokenID: 33, Timestamp: ts, Name: vss.FieldLowVoltageBatteryCurrentVoltage, ValueNumber: 14.335, Source: "ruptela/TODO"}
+	batch := "[" + fmt.Sprintf(event, "0") + "," + fmt.Sprintf(event, "ZZ") + `,{"dataversion":"r/v0/unknown"}]`
+	results, err := status.DecodeStatusSignalsBatch(strings.NewReader(batch))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.NoError(t, results[0].Err)
+	require.ElementsMatch(t, []vss.Signal{
+		voltage,
+		{TokenID: 33, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 0, Source: "ruptela/TODO"},
+	}, results[0].Signals)
+
+	// the signals decoded before the error are kept from the conversion error.
+	require.Equal(t, 1, results[1].Index)
+	var convErr convert.ConversionError
+	require.ErrorAs(t, results[1].Err, &convErr)
+	require.Len(t, convErr.Errors, 1)
+	require.Equal(t, []vss.Signal{voltage}, results[1].Signals)
+
+	require.Equal(t, 2, results[2].Index)
+	require.Error(t, results[2].Err)
+	require.Empty(t, results[2].Signals)
+
+	_, err = status.DecodeStatusSignalsBatch(strings.NewReader("[" + fmt.Sprintf(event, "0")))
+	require.Error(t, err, "a truncated batch is malformed")
+}
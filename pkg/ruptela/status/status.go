@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
@@ -14,8 +15,12 @@ import (
 	"github.com/tidwall/gjson"
 )
 
+// errLocationOptions is returned when options are given for a location message, which has no OIDs.
+var errLocationOptions = errors.New("location messages have no OIDs, options are not supported")
+
 // DecodeStatusSignals decodes a status message into a slice of signals.
-func DecodeStatusSignals(msgBytes []byte) ([]vss.Signal, error) {
+// The options apply to status and device status messages, an error is returned if any are given for a location message.
+func DecodeStatusSignals(msgBytes []byte, opts ...Option) ([]vss.Signal, error) {
 	event := cloudevent.CloudEvent[struct{}]{}
 	err := json.Unmarshal(msgBytes, &event)
 	if err != nil {
@@ -24,9 +29,14 @@ func DecodeStatusSignals(msgBytes []byte) ([]vss.Signal, error) {
 	var signals []vss.Signal
 	switch event.DataVersion {
 	case ruptela.StatusEventDS:
-		signals, err = SignalsFromV1Payload(msgBytes)
+		signals, err = SignalsFromV1Payload(msgBytes, opts...)
 	case ruptela.LocationEventDS:
+		if len(opts) != 0 {
+			return nil, errLocationOptions
+		}
 		signals, err = SignalsFromLocationPayload(msgBytes)
+	case ruptela.DevStatusDS:
+		signals, err = SignalsFromDevStatusPayload(msgBytes, opts...)
 	default:
 		return nil, fmt.Errorf("unknown data version: %s", event.DataVersion)
 	}
@@ -37,6 +47,14 @@ func DecodeStatusSignals(msgBytes []byte) ([]vss.Signal, error) {
 	return signals, nil
 }
 
+// DecodeStatusSignalsBatch decodes each status message of a batch of CloudEvents into a slice of signals.
+// Errors for a single message are returned with its signals, the error is only set if the batch is malformed.
+func DecodeStatusSignalsBatch(r io.Reader, opts ...Option) ([]convert.EventSignals, error) {
+	return convert.DecodeBatch(r, func(msgBytes []byte) ([]vss.Signal, error) {
+		return DecodeStatusSignals(msgBytes, opts...)
+	})
+}
+
 // SubjectFromV1Data gets a subject from a v1 payload.
 func SubjectFromV1Data(jsonData []byte) (string, error) {
 	result := gjson.GetBytes(jsonData, "subject")
@@ -83,11 +101,11 @@ func TokenIDFromData(jsonData []byte) (uint32, error) {
 	if !ok {
 		return 0, fmt.Errorf("%s field is not a string", lookupKey)
 	}
-	subjectDID, err := cloudevent.DecodeNFTDID(subjectStr)
+	tokenID, err := cloudevent.TokenIDFromDID(subjectStr)
 	if err != nil {
 		return 0, fmt.Errorf("error decoding subject: %w", err)
 	}
-	return subjectDID.TokenID, nil
+	return tokenID, nil
 }
 
 // SourceFromData gets a source from a V2 payload.
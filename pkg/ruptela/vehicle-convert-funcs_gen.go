@@ -1,6 +1,12 @@
 // Code generated by github.com/DIMO-Network/model-garage.
 package ruptela
 
+import (
+	"slices"
+
+	"github.com/DIMO-Network/model-garage/pkg/convert"
+)
+
 // This file is automatically populated with conversion functions for each field of the model struct.
 // any conversion functions already defined in this package will be coppied through.
 // note: DO NOT mutate the orginalDoc parameter which is shared between all conversion functions.
@@ -9,36 +15,52 @@ package ruptela
 // Vehicle.Chassis.Axle.Row1.Wheel.Left.Tire.Pressure: Tire pressure in kilo-Pascal.
 // Unit: 'kPa'
 func ToChassisAxleRow1WheelLeftTirePressure0(originalDoc []byte, val string) (float64, error) {
-	return Convert960(val)
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt) / 20, nil
 }
 
 // ToChassisAxleRow1WheelRightTirePressure0 converts data from field 'signals.961' of type string to 'Vehicle.Chassis.Axle.Row1.Wheel.Right.Tire.Pressure' of type float64.
 // Vehicle.Chassis.Axle.Row1.Wheel.Right.Tire.Pressure: Tire pressure in kilo-Pascal.
 // Unit: 'kPa'
 func ToChassisAxleRow1WheelRightTirePressure0(originalDoc []byte, val string) (float64, error) {
-	return Convert961(val)
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt) / 20, nil
 }
 
 // ToChassisAxleRow2WheelLeftTirePressure0 converts data from field 'signals.962' of type string to 'Vehicle.Chassis.Axle.Row2.Wheel.Left.Tire.Pressure' of type float64.
 // Vehicle.Chassis.Axle.Row2.Wheel.Left.Tire.Pressure: Tire pressure in kilo-Pascal.
 // Unit: 'kPa'
 func ToChassisAxleRow2WheelLeftTirePressure0(originalDoc []byte, val string) (float64, error) {
-	return Convert962(val)
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt) / 20, nil
 }
 
 // ToChassisAxleRow2WheelRightTirePressure0 converts data from field 'signals.963' of type string to 'Vehicle.Chassis.Axle.Row2.Wheel.Right.Tire.Pressure' of type float64.
 // Vehicle.Chassis.Axle.Row2.Wheel.Right.Tire.Pressure: Tire pressure in kilo-Pascal.
 // Unit: 'kPa'
 func ToChassisAxleRow2WheelRightTirePressure0(originalDoc []byte, val string) (float64, error) {
-	return Convert963(val)
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt) / 20, nil
 }
 
 // ToCurrentLocationAltitude0 converts data from field 'pos.alt' of type float64 to 'Vehicle.CurrentLocation.Altitude' of type float64.
 // Vehicle.CurrentLocation.Altitude: Current altitude relative to WGS 84 reference ellipsoid, as measured at the position of GNSS receiver antenna.
 // Unit: 'm'
 func ToCurrentLocationAltitude0(originalDoc []byte, val float64) (float64, error) {
-	if val == 0x8000 {
-		return 0, errNotFound
+	if slices.Contains([]float64{32768}, val) {
+		return 0, convert.ErrNotFound
 	}
 	return val / 10, nil
 }
@@ -47,8 +69,8 @@ func ToCurrentLocationAltitude0(originalDoc []byte, val float64) (float64, error
 // Vehicle.CurrentLocation.Latitude: Current latitude of vehicle in WGS 84 geodetic coordinates, as measured at the position of GNSS receiver antenna.
 // Unit: 'degrees' Min: '-90' Max: '90'
 func ToCurrentLocationLatitude0(originalDoc []byte, val float64) (float64, error) {
-	if val == -0x80000000 {
-		return 0, errNotFound
+	if slices.Contains([]float64{-2147483648}, val) {
+		return 0, convert.ErrNotFound
 	}
 	return val / 10000000, nil
 }
@@ -57,8 +79,8 @@ func ToCurrentLocationLatitude0(originalDoc []byte, val float64) (float64, error
 // Vehicle.CurrentLocation.Longitude: Current longitude of vehicle in WGS 84 geodetic coordinates, as measured at the position of GNSS receiver antenna.
 // Unit: 'degrees' Min: '-180' Max: '180'
 func ToCurrentLocationLongitude0(originalDoc []byte, val float64) (float64, error) {
-	if val == -0x80000000 {
-		return 0, errNotFound
+	if slices.Contains([]float64{-2147483648}, val) {
+		return 0, convert.ErrNotFound
 	}
 	return val / 10000000, nil
 }
@@ -66,8 +88,8 @@ func ToCurrentLocationLongitude0(originalDoc []byte, val float64) (float64, erro
 // ToDIMOAftermarketHDOP0 converts data from field 'pos.hdop' of type float64 to 'Vehicle.DIMO.Aftermarket.HDOP' of type float64.
 // Vehicle.DIMO.Aftermarket.HDOP: Horizontal dilution of precision of GPS
 func ToDIMOAftermarketHDOP0(originalDoc []byte, val float64) (float64, error) {
-	if val == 0xff {
-		return 0, errNotFound
+	if slices.Contains([]float64{255}, val) {
+		return 0, convert.ErrNotFound
 	}
 	return val, nil
 }
@@ -75,8 +97,8 @@ func ToDIMOAftermarketHDOP0(originalDoc []byte, val float64) (float64, error) {
 // ToDIMOAftermarketNSAT0 converts data from field 'pos.sat' of type float64 to 'Vehicle.DIMO.Aftermarket.NSAT' of type float64.
 // Vehicle.DIMO.Aftermarket.NSAT: Number of sync satellites for GPS
 func ToDIMOAftermarketNSAT0(originalDoc []byte, val float64) (float64, error) {
-	if val == 0xff {
-		return 0, errNotFound
+	if slices.Contains([]float64{255}, val) {
+		return 0, convert.ErrNotFound
 	}
 	return val, nil
 }
@@ -85,73 +107,120 @@ func ToDIMOAftermarketNSAT0(originalDoc []byte, val float64) (float64, error) {
 // Vehicle.Exterior.AirTemperature: Air temperature outside the vehicle.
 // Unit: 'celsius'
 func ToExteriorAirTemperature0(originalDoc []byte, val string) (float64, error) {
-	return Convert97(val)
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt) - 40, nil
 }
 
 // ToLowVoltageBatteryCurrentVoltage0 converts data from field 'signals.29' of type string to 'Vehicle.LowVoltageBattery.CurrentVoltage' of type float64.
 // Vehicle.LowVoltageBattery.CurrentVoltage: Current Voltage of the low voltage battery.
 // Unit: 'V'
 func ToLowVoltageBatteryCurrentVoltage0(originalDoc []byte, val string) (float64, error) {
+	ret, err := toLowVoltageBatteryCurrentVoltage0(originalDoc, val)
+	if err != nil {
+		return 0, err
+	}
+	return ret / 1000, nil
+}
+
+// toLowVoltageBatteryCurrentVoltage0 converts data from field 'signals.29' of type string to 'Vehicle.LowVoltageBattery.CurrentVoltage' in the unit 'mV'.
+// ToLowVoltageBatteryCurrentVoltage0 converts the value to 'V'.
+func toLowVoltageBatteryCurrentVoltage0(originalDoc []byte, val string) (float64, error) {
 	if unplugged(originalDoc) {
 		return 0, errNotFound
 	}
-	mV, err := ignoreZero(Convert29(val))
+	rawInt, err := convert.HexUint(val, 2)
 	if err != nil {
 		return 0, err
 	}
-
-	return mV / 1000, nil
+	return ignoreZero(float64(rawInt), nil)
 }
 
 // ToOBDDistanceWithMIL0 converts data from field 'signals.102' of type string to 'Vehicle.OBD.DistanceWithMIL' of type float64.
 // Vehicle.OBD.DistanceWithMIL: PID 21 - Distance traveled with MIL on
 // Unit: 'km'
 func ToOBDDistanceWithMIL0(originalDoc []byte, val string) (float64, error) {
-	return Convert102(val)
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt), nil
 }
 
 // ToOBDRunTime0 converts data from field 'signals.107' of type string to 'Vehicle.OBD.RunTime' of type float64.
 // Vehicle.OBD.RunTime: PID 1F - Engine run time
 // Unit: 's'
 func ToOBDRunTime0(originalDoc []byte, val string) (float64, error) {
-	return ignoreZero(Convert107(val))
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]uint64{0}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt), nil
 }
 
 // ToPowertrainCombustionEngineDieselExhaustFluidCapacity0 converts data from field 'signals.1148' of type string to 'Vehicle.Powertrain.CombustionEngine.DieselExhaustFluid.Capacity' of type float64.
 // Vehicle.Powertrain.CombustionEngine.DieselExhaustFluid.Capacity: Capacity in liters of the Diesel Exhaust Fluid Tank.
 // Unit: 'l'
 func ToPowertrainCombustionEngineDieselExhaustFluidCapacity0(originalDoc []byte, val string) (float64, error) {
-	return Convert1148(val)
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt), nil
 }
 
 // ToPowertrainCombustionEngineDieselExhaustFluidCapacity1 converts data from field 'signals.1149' of type string to 'Vehicle.Powertrain.CombustionEngine.DieselExhaustFluid.Capacity' of type float64.
 // Vehicle.Powertrain.CombustionEngine.DieselExhaustFluid.Capacity: Capacity in liters of the Diesel Exhaust Fluid Tank.
 // Unit: 'l'
 func ToPowertrainCombustionEngineDieselExhaustFluidCapacity1(originalDoc []byte, val string) (float64, error) {
-	return ignoreZero(Convert1149(val))
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]uint64{0}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt), nil
 }
 
 // ToPowertrainCombustionEngineDieselExhaustFluidLevel0 converts data from field 'signals.1150' of type string to 'Vehicle.Powertrain.CombustionEngine.DieselExhaustFluid.Level' of type float64.
 // Vehicle.Powertrain.CombustionEngine.DieselExhaustFluid.Level: Level of the Diesel Exhaust Fluid tank as percent of capacity. 0 = empty. 100 = full.
 // Unit: 'percent' Min: '0' Max: '100'
 func ToPowertrainCombustionEngineDieselExhaustFluidLevel0(originalDoc []byte, val string) (float64, error) {
-	return Convert1150(val)
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	if rawInt > 250 {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt) * 0.4, nil
 }
 
 // ToPowertrainCombustionEngineECT0 converts data from field 'signals.96' of type string to 'Vehicle.Powertrain.CombustionEngine.ECT' of type float64.
 // Vehicle.Powertrain.CombustionEngine.ECT: Engine coolant temperature.
 // Unit: 'celsius'
 func ToPowertrainCombustionEngineECT0(originalDoc []byte, val string) (float64, error) {
-	return Convert96(val)
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt) - 40, nil
 }
 
 // ToPowertrainCombustionEngineEngineOilLevel0 converts data from field 'signals.964' of type string to 'Vehicle.Powertrain.CombustionEngine.EngineOilLevel' of type string.
 // Vehicle.Powertrain.CombustionEngine.EngineOilLevel: Engine oil level.
 func ToPowertrainCombustionEngineEngineOilLevel0(originalDoc []byte, val string) (string, error) {
-	num, err := Convert964(val)
+	rawInt, err := convert.HexUint(val, 1)
 	if err != nil {
 		return "", err
 	}
+	num := float64(rawInt) * 0.393
 	switch {
 	case num < 0.25:
 		return "CRITICALLY_LOW", nil
@@ -170,128 +239,194 @@ func ToPowertrainCombustionEngineEngineOilLevel0(originalDoc []byte, val string)
 // Vehicle.Powertrain.CombustionEngine.EngineOilRelativeLevel: Engine oil level as a percentage.
 // Unit: 'percent' Min: '0' Max: '100'
 func ToPowertrainCombustionEngineEngineOilRelativeLevel0(originalDoc []byte, val string) (float64, error) {
-	return ignoreZero(Convert964(val))
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]uint64{0}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt) * 0.393, nil
 }
 
 // ToPowertrainCombustionEngineSpeed0 converts data from field 'signals.94' of type string to 'Vehicle.Powertrain.CombustionEngine.Speed' of type float64.
 // Vehicle.Powertrain.CombustionEngine.Speed: Engine speed measured as rotations per minute.
 // Unit: 'rpm'
 func ToPowertrainCombustionEngineSpeed0(originalDoc []byte, val string) (float64, error) {
-	return ignoreZero(Convert94(val))
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]uint64{0}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt) / 4, nil
 }
 
 // ToPowertrainCombustionEngineTPS0 converts data from field 'signals.103' of type string to 'Vehicle.Powertrain.CombustionEngine.TPS' of type float64.
 // Vehicle.Powertrain.CombustionEngine.TPS: Current throttle position.
 // Unit: 'percent'  Max: '100'
 func ToPowertrainCombustionEngineTPS0(originalDoc []byte, val string) (float64, error) {
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
 	if ignitionOff(originalDoc) {
-		return ignoreZero(Convert103(val))
+		return ignoreZero(float64(rawInt)*(100.0/255), nil)
 	}
-	return Convert103(val)
+	return float64(rawInt) * (100.0 / 255), nil
 }
 
 // ToPowertrainFuelSystemAbsoluteLevel0 converts data from field 'signals.642' of type string to 'Vehicle.Powertrain.FuelSystem.AbsoluteLevel' of type float64.
 // Vehicle.Powertrain.FuelSystem.AbsoluteLevel: Current available fuel in the fuel tank expressed in liters.
 // Unit: 'l'
 func ToPowertrainFuelSystemAbsoluteLevel0(originalDoc []byte, val string) (float64, error) {
-	return Convert642(val)
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt), nil
 }
 
 // ToPowertrainFuelSystemAbsoluteLevel1 converts data from field 'signals.205' of type string to 'Vehicle.Powertrain.FuelSystem.AbsoluteLevel' of type float64.
 // Vehicle.Powertrain.FuelSystem.AbsoluteLevel: Current available fuel in the fuel tank expressed in liters.
 // Unit: 'l'
 func ToPowertrainFuelSystemAbsoluteLevel1(originalDoc []byte, val string) (float64, error) {
-	return ignoreZero(Convert205(val))
+	rawInt, err := convert.HexUint(val, 2)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]uint64{0}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt), nil
 }
 
 // ToPowertrainFuelSystemRelativeLevel0 converts data from field 'signals.98' of type string to 'Vehicle.Powertrain.FuelSystem.RelativeLevel' of type float64.
 // Vehicle.Powertrain.FuelSystem.RelativeLevel: Level in fuel tank as percent of capacity. 0 = empty. 100 = full.
 // Unit: 'percent' Min: '0' Max: '100'
 func ToPowertrainFuelSystemRelativeLevel0(originalDoc []byte, val string) (float64, error) {
-	return ignoreZero(Convert98(val))
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]uint64{0}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt) * 0.39215686274509803, nil
 }
 
 // ToPowertrainFuelSystemRelativeLevel1 converts data from field 'signals.207' of type string to 'Vehicle.Powertrain.FuelSystem.RelativeLevel' of type float64.
 // Vehicle.Powertrain.FuelSystem.RelativeLevel: Level in fuel tank as percent of capacity. 0 = empty. 100 = full.
 // Unit: 'percent' Min: '0' Max: '100'
 func ToPowertrainFuelSystemRelativeLevel1(originalDoc []byte, val string) (float64, error) {
-	return ignoreZero(Convert207(val))
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	if slices.Contains([]uint64{0}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	if rawInt > 250 {
+		return 0, convert.ErrNotFound
+	}
+	return float64(rawInt) * 0.4, nil
 }
 
 // ToPowertrainTractionBatteryRange0 converts data from field 'signals.723' of type string to 'Vehicle.Powertrain.TractionBattery.Range' of type float64.
 // Vehicle.Powertrain.TractionBattery.Range: Remaining range in meters using only battery.
 // Unit: 'm'
 func ToPowertrainTractionBatteryRange0(originalDoc []byte, val string) (float64, error) {
-	floatValKm, err := Convert723(val)
+	rawInt, err := convert.HexUint(val, 2)
 	if err != nil {
 		return 0, err
 	}
-	// convert km to m
-	return floatValKm / 1000, nil
+	return float64(rawInt) * 1000, nil
 }
 
 // ToPowertrainTractionBatteryStateOfChargeCurrent0 converts data from field 'signals.722' of type string to 'Vehicle.Powertrain.TractionBattery.StateOfCharge.Current' of type float64.
 // Vehicle.Powertrain.TractionBattery.StateOfCharge.Current: Physical state of charge of the high voltage battery, relative to net capacity. This is not necessarily the state of charge being displayed to the customer.
 // Unit: 'percent' Min: '0' Max: '100.0'
 func ToPowertrainTractionBatteryStateOfChargeCurrent0(originalDoc []byte, val string) (float64, error) {
-	return Convert722(val)
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt), nil
 }
 
 // ToPowertrainTransmissionTravelledDistance0 converts data from field 'signals.645' of type string to 'Vehicle.Powertrain.Transmission.TravelledDistance' of type float64.
 // Vehicle.Powertrain.Transmission.TravelledDistance: Odometer reading, total distance travelled during the lifetime of the transmission.
-// Unit: 'km'
+// Unit: 'km' Min: '0'
 func ToPowertrainTransmissionTravelledDistance0(originalDoc []byte, val string) (float64, error) {
-	return Convert645(val)
+	rawInt, err := convert.HexUint(val, 4)
+	if err != nil {
+		return 0, err
+	}
+	return float64(rawInt), nil
 }
 
 // ToPowertrainTransmissionTravelledDistance1 converts data from field 'signals.114' of type string to 'Vehicle.Powertrain.Transmission.TravelledDistance' of type float64.
 // Vehicle.Powertrain.Transmission.TravelledDistance: Odometer reading, total distance travelled during the lifetime of the transmission.
-// Unit: 'km'
+// Unit: 'km' Min: '0'
 func ToPowertrainTransmissionTravelledDistance1(originalDoc []byte, val string) (float64, error) {
-	num, err := ignoreZero(Convert114(val))
+	rawInt, err := convert.HexUint(val, 4)
 	if err != nil {
 		return 0, err
 	}
-	// convert m to km
-	return num / 1000, nil
+	if slices.Contains([]uint64{0}, rawInt) {
+		return 0, convert.ErrNotFound
+	}
+	if rawInt > 4211081215 {
+		return 0, convert.ErrNotFound
+	}
+	return (float64(rawInt) * 5) / 1000, nil
 }
 
 // ToPowertrainType0 converts data from field 'signals.99' of type string to 'Vehicle.Powertrain.Type' of type string.
 // Vehicle.Powertrain.Type: Defines the powertrain type of the vehicle.
 func ToPowertrainType0(originalDoc []byte, val string) (string, error) {
-	num, err := Convert99(val)
+	rawInt, err := convert.HexUint(val, 1)
 	if err != nil {
 		return "", err
 	}
-	return fuelTypeConversion(num)
+	return fuelTypeConversion(float64(rawInt))
 }
 
 // ToPowertrainType1 converts data from field 'signals.483' of type string to 'Vehicle.Powertrain.Type' of type string.
 // Vehicle.Powertrain.Type: Defines the powertrain type of the vehicle.
 func ToPowertrainType1(originalDoc []byte, val string) (string, error) {
-	num, err := Convert483(val)
+	rawInt, err := convert.HexUint(val, 1)
 	if err != nil {
 		return "", err
 	}
-	return fuelTypeConversion(num)
+	// values above 250 are error values in the OID table.
+	if rawInt > 250 {
+		return "", errNotFound
+	}
+	return fuelTypeConversion(float64(rawInt))
 }
 
 // ToSpeed0 converts data from field 'signals.95' of type string to 'Vehicle.Speed' of type float64.
 // Vehicle.Speed: Vehicle speed.
 // Unit: 'km/h'
 func ToSpeed0(originalDoc []byte, val string) (float64, error) {
+	rawInt, err := convert.HexUint(val, 1)
+	if err != nil {
+		return 0, err
+	}
 	if ignitionOff(originalDoc) {
-		return ignoreZero(Convert95(val))
+		return ignoreZero(float64(rawInt), nil)
 	}
-	return Convert95(val)
+	return float64(rawInt), nil
 }
 
 // ToSpeed1 converts data from field 'pos.spd' of type float64 to 'Vehicle.Speed' of type float64.
 // Vehicle.Speed: Vehicle speed.
 // Unit: 'km/h'
 func ToSpeed1(originalDoc []byte, val float64) (float64, error) {
-	if val == 0xffff {
-		return 0, errNotFound
+	if slices.Contains([]float64{65535}, val) {
+		return 0, convert.ErrNotFound
 	}
 	return val, nil
 }
@@ -20,7 +20,9 @@ func SignalsFromLocationData(originalDoc []byte, baseSignal vss.Signal, signalNa
 	case "alt":
 		val0, err := CurrentLocationAltitudeFromLocationData(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.alt': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.alt': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -28,14 +30,19 @@ func SignalsFromLocationData(originalDoc []byte, baseSignal vss.Signal, signalNa
 				Source:    baseSignal.Source,
 				Name:      "currentLocationAltitude",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("double", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'pos.alt': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 
 	case "hdop":
 		val0, err := DIMOAftermarketHDOPFromLocationData(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.hdop': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.hdop': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -43,14 +50,19 @@ func SignalsFromLocationData(originalDoc []byte, baseSignal vss.Signal, signalNa
 				Source:    baseSignal.Source,
 				Name:      "dimoAftermarketHDOP",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'pos.hdop': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 
 	case "lat":
 		val0, err := CurrentLocationLatitudeFromLocationData(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.lat': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.lat': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -58,14 +70,19 @@ func SignalsFromLocationData(originalDoc []byte, baseSignal vss.Signal, signalNa
 				Source:    baseSignal.Source,
 				Name:      "currentLocationLatitude",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("double", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'pos.lat': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 
 	case "lon":
 		val0, err := CurrentLocationLongitudeFromLocationData(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.lon': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.lon': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -73,14 +90,19 @@ func SignalsFromLocationData(originalDoc []byte, baseSignal vss.Signal, signalNa
 				Source:    baseSignal.Source,
 				Name:      "currentLocationLongitude",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("double", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'pos.lon': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 
 	case "sat":
 		val0, err := DIMOAftermarketNSATFromLocationData(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.sat': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.sat': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -88,14 +110,19 @@ func SignalsFromLocationData(originalDoc []byte, baseSignal vss.Signal, signalNa
 				Source:    baseSignal.Source,
 				Name:      "dimoAftermarketNSAT",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'pos.sat': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 
 	case "spd":
 		val0, err := SpeedFromLocationData(originalDoc, valResult)
 		if err != nil {
-			retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.spd': %w", err))
+			if !errors.Is(err, errNotFound) {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to convert 'pos.spd': %w", err))
+			}
 		} else {
 			sig := vss.Signal{
 				TokenID:   baseSignal.TokenID,
@@ -103,8 +130,11 @@ func SignalsFromLocationData(originalDoc []byte, baseSignal vss.Signal, signalNa
 				Source:    baseSignal.Source,
 				Name:      "speed",
 			}
-			sig.SetValue(val0)
-			ret = append(ret, sig)
+			if err := sig.SetTypedValue("float", val0); err != nil {
+				retErrs = errors.Join(retErrs, fmt.Errorf("failed to set 'pos.spd': %w", err))
+			} else {
+				ret = append(ret, sig)
+			}
 		}
 
 	default:
@@ -136,6 +166,9 @@ func CurrentLocationLatitudeFromLocationData(originalDoc []byte, result gjson.Re
 	val0, ok := result.Value().(float64)
 	if ok {
 		ret, err = ToCurrentLocationLatitude0(originalDoc, val0)
+		if err == nil {
+			ret, err = convert.ApplyRange("error", val0, ret, -90, 90)
+		}
 		if err == nil {
 			return ret, nil
 		}
@@ -153,6 +186,9 @@ func CurrentLocationLongitudeFromLocationData(originalDoc []byte, result gjson.R
 	val0, ok := result.Value().(float64)
 	if ok {
 		ret, err = ToCurrentLocationLongitude0(originalDoc, val0)
+		if err == nil {
+			ret, err = convert.ApplyRange("error", val0, ret, -180, 180)
+		}
 		if err == nil {
 			return ret, nil
 		}
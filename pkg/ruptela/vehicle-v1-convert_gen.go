@@ -4,13 +4,14 @@ package ruptela
 import (
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/DIMO-Network/model-garage/pkg/convert"
 	"github.com/DIMO-Network/model-garage/pkg/vss"
 	"github.com/tidwall/gjson"
 )
 
-var errNotFound = errors.New("field not found")
+var errNotFound = convert.ErrNotFound
 
 // SignalsFromV1Data creates a slice of vss.Signal from the given v1 status JSON data.
 // On error, partial results may be returned.
@@ -33,8 +34,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow1WheelLeftTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow1WheelRightTirePressureFromV1Data(jsonData)
@@ -49,8 +53,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow1WheelRightTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow2WheelLeftTirePressureFromV1Data(jsonData)
@@ -65,8 +72,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow2WheelLeftTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ChassisAxleRow2WheelRightTirePressureFromV1Data(jsonData)
@@ -81,8 +91,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow2WheelRightTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = CurrentLocationAltitudeFromV1Data(jsonData)
@@ -97,8 +110,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("double", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationAltitude': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = CurrentLocationLatitudeFromV1Data(jsonData)
@@ -113,8 +129,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("double", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationLatitude': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = CurrentLocationLongitudeFromV1Data(jsonData)
@@ -129,8 +148,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("double", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationLongitude': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = DIMOAftermarketHDOPFromV1Data(jsonData)
@@ -145,8 +167,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'DIMOAftermarketHDOP': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = DIMOAftermarketNSATFromV1Data(jsonData)
@@ -161,8 +186,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'DIMOAftermarketNSAT': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = ExteriorAirTemperatureFromV1Data(jsonData)
@@ -177,8 +205,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ExteriorAirTemperature': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = LowVoltageBatteryCurrentVoltageFromV1Data(jsonData)
@@ -193,8 +224,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'LowVoltageBatteryCurrentVoltage': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDDistanceWithMILFromV1Data(jsonData)
@@ -209,8 +243,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDDistanceWithMIL': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = OBDRunTimeFromV1Data(jsonData)
@@ -225,8 +262,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'OBDRunTime': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineDieselExhaustFluidCapacityFromV1Data(jsonData)
@@ -241,8 +281,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineDieselExhaustFluidCapacity': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineDieselExhaustFluidLevelFromV1Data(jsonData)
@@ -257,8 +300,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineDieselExhaustFluidLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineECTFromV1Data(jsonData)
@@ -273,8 +319,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("int16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineECT': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineEngineOilLevelFromV1Data(jsonData)
@@ -289,8 +338,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("string", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineEngineOilLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineEngineOilRelativeLevelFromV1Data(jsonData)
@@ -305,8 +357,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineEngineOilRelativeLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineSpeedFromV1Data(jsonData)
@@ -321,8 +376,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineSpeed': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainCombustionEngineTPSFromV1Data(jsonData)
@@ -337,8 +395,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainCombustionEngineTPS': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainFuelSystemAbsoluteLevelFromV1Data(jsonData)
@@ -353,8 +414,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainFuelSystemAbsoluteLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainFuelSystemRelativeLevelFromV1Data(jsonData)
@@ -369,8 +433,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainFuelSystemRelativeLevel': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryRangeFromV1Data(jsonData)
@@ -385,8 +452,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint32", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryRange': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTractionBatteryStateOfChargeCurrentFromV1Data(jsonData)
@@ -401,8 +471,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryStateOfChargeCurrent': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTransmissionTravelledDistanceFromV1Data(jsonData)
@@ -417,8 +490,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTransmissionTravelledDistance': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = PowertrainTypeFromV1Data(jsonData)
@@ -433,8 +509,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("string", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainType': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, err = SpeedFromV1Data(jsonData)
@@ -449,8 +528,11 @@ func SignalsFromV1Data(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []
 			Timestamp: baseSignal.Timestamp,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'Speed': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 	return retSignals, errs
 }
@@ -589,6 +671,9 @@ func CurrentLocationLatitudeFromV1Data(jsonData []byte) (ret float64, err error)
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToCurrentLocationLatitude0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, -90, 90)
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -614,6 +699,9 @@ func CurrentLocationLongitudeFromV1Data(jsonData []byte) (ret float64, err error
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToCurrentLocationLongitude0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, -180, 180)
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -877,6 +965,9 @@ func PowertrainCombustionEngineEngineOilLevelFromV1Data(jsonData []byte) (ret st
 		val, ok := result.Value().(string)
 		if ok {
 			retVal, err := ToPowertrainCombustionEngineEngineOilLevel0(jsonData, val)
+			if err == nil {
+				err = convert.CheckAllowed(retVal, "CRITICALLY_LOW", "LOW", "NORMAL", "HIGH", "CRITICALLY_HIGH")
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -1103,6 +1194,9 @@ func PowertrainTransmissionTravelledDistanceFromV1Data(jsonData []byte) (ret flo
 		val, ok := result.Value().(string)
 		if ok {
 			retVal, err := ToPowertrainTransmissionTravelledDistance0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, 0, math.Inf(1))
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -1116,6 +1210,9 @@ func PowertrainTransmissionTravelledDistanceFromV1Data(jsonData []byte) (ret flo
 		val, ok := result.Value().(string)
 		if ok {
 			retVal, err := ToPowertrainTransmissionTravelledDistance1(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, 0, math.Inf(1))
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -1141,6 +1238,9 @@ func PowertrainTypeFromV1Data(jsonData []byte) (ret string, err error) {
 		val, ok := result.Value().(string)
 		if ok {
 			retVal, err := ToPowertrainType0(jsonData, val)
+			if err == nil {
+				err = convert.CheckAllowed(retVal, "COMBUSTION", "HYBRID", "ELECTRIC")
+			}
 			if err == nil {
 				return retVal, nil
 			}
@@ -1154,6 +1254,9 @@ func PowertrainTypeFromV1Data(jsonData []byte) (ret string, err error) {
 		val, ok := result.Value().(string)
 		if ok {
 			retVal, err := ToPowertrainType1(jsonData, val)
+			if err == nil {
+				err = convert.CheckAllowed(retVal, "COMBUSTION", "HYBRID", "ELECTRIC")
+			}
 			if err == nil {
 				return retVal, nil
 			}
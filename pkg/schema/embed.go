@@ -2,6 +2,7 @@ package schema
 
 import (
 	_ "embed"
+	"strings"
 
 	"github.com/DIMO-Network/model-garage/pkg/nativestatus/schema"
 )
@@ -9,12 +10,20 @@ import (
 //go:embed spec/vss_rel_4.2-DIMO-*.csv
 var vssRel42DIMO string
 
+// dimoOverlay holds DIMO signals that are not yet in the pinned VSS release.
+// It has the same columns as the pinned CSV and is merged into it by VssRel42DIMO.
+//
+//go:embed spec/dimo-overlay.csv
+var dimoOverlay string
+
 //go:embed spec/default-definitions.yaml
 var defaultDefinitionsYAML string
 
 // VssRel42DIMO is the embedded CSV file containing the VSS schema for DIMO.
+// The rows of the DIMO overlay spec are appended to the pinned VSS release.
 func VssRel42DIMO() string {
-	return vssRel42DIMO
+	_, rows, _ := strings.Cut(dimoOverlay, "\n")
+	return vssRel42DIMO + rows
 }
 
 // DefinitionsYAML is the embedded YAML file containing the definitions.yaml for the VSS schema.
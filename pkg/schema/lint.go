@@ -0,0 +1,185 @@
+package schema
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// LintSeverityError marks an issue that will cause missing or incorrect signals.
+	LintSeverityError = "error"
+	// LintSeverityWarning marks an issue that should be reviewed but may be intended.
+	LintSeverityWarning = "warning"
+
+	// LintRuleInvalid is reported for definitions that fail validation.
+	LintRuleInvalid = "invalid-definition"
+	// LintRuleUnknownVspec is reported for vspecNames that are not in the spec.
+	LintRuleUnknownVspec = "unknown-vspec"
+	// LintRuleDuplicateVspec is reported for vspecNames that are defined more than once.
+	LintRuleDuplicateVspec = "duplicate-vspec"
+	// LintRuleDuplicateOriginalName is reported for originalNames that are used by more than one signal.
+	LintRuleDuplicateOriginalName = "duplicate-original-name"
+	// LintRuleTypeMismatch is reported when an originalType does not match the Go type of the VSS datatype.
+	LintRuleTypeMismatch = "type-mismatch"
+	// LintRuleDeprecated is reported for signals that are deprecated in the spec.
+	LintRuleDeprecated = "deprecated"
+)
+
+// LintIssue is a single problem found in a definitions file.
+type LintIssue struct {
+	Rule         string `json:"rule"`
+	Severity     string `json:"severity"`
+	VspecName    string `json:"vspecName"`
+	OriginalName string `json:"originalName,omitempty"`
+	// Line is the line of the definition or conversion in the definitions file.
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// HasLintErrors returns true if any of the issues has an error severity.
+func HasLintErrors(issues []LintIssue) bool {
+	return slices.ContainsFunc(issues, func(issue LintIssue) bool {
+		return issue.Severity == LintSeverityError
+	})
+}
+
+// lintDefinition is a definition along with the lines it was read from.
+type lintDefinition struct {
+	info            *DefinitionInfo
+	line            int
+	conversionLines []int
+}
+
+// LintDefinitions checks a definitions file against a vspec CSV file.
+// Unlike GetDefinedSignals, which skips anything it cannot use, every problem is returned as a LintIssue.
+func LintDefinitions(specReader, definitionReader io.Reader) ([]LintIssue, error) {
+	signals, err := LoadSignalsCSV(specReader)
+	if err != nil {
+		return nil, fmt.Errorf("error reading signals: %w", err)
+	}
+	specSignals := make(map[string]*SignalInfo, len(signals))
+	for _, sig := range signals {
+		specSignals[sig.Name] = sig
+	}
+
+	defs, err := decodeLintDefinitions(definitionReader)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := []LintIssue{}
+	firstVspecLine := map[string]int{}
+	originalNameUsers := map[string][]string{}
+	for _, def := range defs {
+		info := def.info
+		if err := Validate(info); err != nil {
+			issues = append(issues, LintIssue{
+				Rule: LintRuleInvalid, Severity: LintSeverityError, VspecName: info.VspecName, Line: def.line, Message: err.Error(),
+			})
+		}
+		if line, ok := firstVspecLine[info.VspecName]; ok {
+			issues = append(issues, LintIssue{
+				Rule: LintRuleDuplicateVspec, Severity: LintSeverityError, VspecName: info.VspecName, Line: def.line,
+				Message: fmt.Sprintf("vspecName is already defined on line %d", line),
+			})
+		} else {
+			firstVspecLine[info.VspecName] = def.line
+		}
+
+		sig, ok := specSignals[info.VspecName]
+		if !ok {
+			issues = append(issues, LintIssue{
+				Rule: LintRuleUnknownVspec, Severity: LintSeverityError, VspecName: info.VspecName, Line: def.line,
+				Message: "vspecName is not defined in the spec",
+			})
+		} else if sig.Deprecated {
+			issues = append(issues, LintIssue{
+				Rule: LintRuleDeprecated, Severity: LintSeverityWarning, VspecName: info.VspecName, Line: def.line,
+				Message: "signal is deprecated in the spec",
+			})
+		}
+
+		for i, conv := range info.Conversions {
+			if conv == nil {
+				continue
+			}
+			users := originalNameUsers[conv.OriginalName]
+			if !slices.Contains(users, info.VspecName) {
+				originalNameUsers[conv.OriginalName] = append(users, info.VspecName)
+			}
+			// conversions that declare a byte size or unit transform are expected to change the type.
+			declaresTransform := conv.HasLinearTransform() || conv.Unit != ""
+			if sig != nil && conv.OriginalType != "" && conv.OriginalType != sig.GOType() && !declaresTransform {
+				issues = append(issues, LintIssue{
+					Rule: LintRuleTypeMismatch, Severity: LintSeverityWarning, VspecName: info.VspecName, OriginalName: conv.OriginalName,
+					Line:    def.conversionLines[i],
+					Message: fmt.Sprintf("originalType '%s' does not match type '%s' of VSS datatype '%s'", conv.OriginalType, sig.GOType(), sig.DataType),
+				})
+			}
+		}
+	}
+
+	for _, def := range defs {
+		for i, conv := range def.info.Conversions {
+			if conv == nil || len(originalNameUsers[conv.OriginalName]) < 2 {
+				continue
+			}
+			issues = append(issues, LintIssue{
+				Rule: LintRuleDuplicateOriginalName, Severity: LintSeverityWarning, VspecName: def.info.VspecName, OriginalName: conv.OriginalName,
+				Line:    def.conversionLines[i],
+				Message: fmt.Sprintf("originalName is used by signals %v", originalNameUsers[conv.OriginalName]),
+			})
+		}
+	}
+
+	slices.SortStableFunc(issues, func(a, b LintIssue) int {
+		return a.Line - b.Line
+	})
+	return issues, nil
+}
+
+// decodeLintDefinitions decodes a definitions file keeping the line number of each definition and conversion.
+func decodeLintDefinitions(r io.Reader) ([]lintDefinition, error) {
+	var root yaml.Node
+	if err := yaml.NewDecoder(r).Decode(&root); err != nil {
+		return nil, fmt.Errorf("failed to decode yaml: %w", err)
+	}
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.SequenceNode {
+		return nil, fmt.Errorf("definitions file must contain a list of definitions")
+	}
+
+	var defs []lintDefinition
+	for _, node := range root.Content[0].Content {
+		info := &DefinitionInfo{}
+		if err := node.Decode(info); err != nil {
+			return nil, fmt.Errorf("failed to decode definition on line %d: %w", node.Line, err)
+		}
+		def := lintDefinition{info: info, line: node.Line}
+		convNodes := mappingValue(node, "conversions")
+		for i := range info.Conversions {
+			line := node.Line
+			if convNodes != nil && i < len(convNodes.Content) {
+				line = convNodes.Content[i].Line
+			}
+			def.conversionLines = append(def.conversionLines, line)
+		}
+		defs = append(defs, def)
+	}
+	return defs, nil
+}
+
+// mappingValue returns the value node for the given key of a mapping node.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const lintSpec = `"Signal","Type","DataType","Deprecated","Unit","Min","Max","Desc","Comment","Allowed","Default","Id"
+"Vehicle.Speed","sensor","float","","km/h","","","Vehicle speed.","","","",""
+"Vehicle.Powertrain.Type","attribute","string","","","","","Powertrain type.","","['COMBUSTION', 'ELECTRIC']","",""
+"Vehicle.OBD.Speed","sensor","float","true","km/h","","","Deprecated speed.","","","",""
+"Vehicle.Exterior.AirTemperature","sensor","float","","celsius","","","Air temperature.","","","",""
+`
+
+const lintDefinitions = `- vspecName: Vehicle.Speed
+  conversions:
+    - originalName: speed
+      originalType: float64
+- vspecName: Vehicle.Sped
+  conversions:
+    - originalName: sped
+      originalType: float64
+- vspecName: Vehicle.Powertrain.Type
+  conversions:
+    - originalName: speed
+      originalType: float64
+- vspecName: Vehicle.OBD.Speed
+  conversions:
+    - originalName: obdSpeed
+      originalType: float64
+- vspecName: Vehicle.Speed
+  conversions:
+    - originalName: ""
+- vspecName: Vehicle.Exterior.AirTemperature
+  conversions:
+    - originalName: signals.97
+      originalType: string
+      byteSize: 1
+      offset: -40
+`
+
+func TestLintDefinitions(t *testing.T) {
+	issues, err := LintDefinitions(strings.NewReader(lintSpec), strings.NewReader(lintDefinitions))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	type issueKey struct {
+		Rule         string
+		Severity     string
+		VspecName    string
+		OriginalName string
+		Line         int
+	}
+	expected := []issueKey{
+		{Rule: LintRuleDuplicateOriginalName, Severity: LintSeverityWarning, VspecName: "Vehicle.Speed", OriginalName: "speed", Line: 3},
+		{Rule: LintRuleUnknownVspec, Severity: LintSeverityError, VspecName: "Vehicle.Sped", Line: 5},
+		{Rule: LintRuleTypeMismatch, Severity: LintSeverityWarning, VspecName: "Vehicle.Powertrain.Type", OriginalName: "speed", Line: 11},
+		{Rule: LintRuleDuplicateOriginalName, Severity: LintSeverityWarning, VspecName: "Vehicle.Powertrain.Type", OriginalName: "speed", Line: 11},
+		{Rule: LintRuleDeprecated, Severity: LintSeverityWarning, VspecName: "Vehicle.OBD.Speed", Line: 13},
+		{Rule: LintRuleInvalid, Severity: LintSeverityError, VspecName: "Vehicle.Speed", Line: 17},
+		{Rule: LintRuleDuplicateVspec, Severity: LintSeverityError, VspecName: "Vehicle.Speed", Line: 17},
+	}
+	actual := make([]issueKey, len(issues))
+	for i, issue := range issues {
+		if issue.Message == "" {
+			t.Errorf("Expected a message for issue %+v", issue)
+		}
+		actual[i] = issueKey{
+			Rule: issue.Rule, Severity: issue.Severity, VspecName: issue.VspecName, OriginalName: issue.OriginalName, Line: issue.Line,
+		}
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Unexpected issues.\nExpected: %+v\nGot: %+v", expected, actual)
+	}
+	if !HasLintErrors(issues) {
+		t.Errorf("Expected lint errors")
+	}
+}
+
+func TestLintDefaultDefinitions(t *testing.T) {
+	issues, err := LintDefinitions(strings.NewReader(VssRel42DIMO()), strings.NewReader(DefaultDefinitionsYAML()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if HasLintErrors(issues) {
+		t.Errorf("Unexpected lint errors in default definitions: %+v", issues)
+	}
+}
@@ -18,9 +18,25 @@ const (
 	maxCol        = 6
 	descCol       = 7
 	colLen        = 8
+	// optional columns
+	commentCol = 8
+	allowedCol = 9
+	defaultCol = 10
+	idCol      = 11
+)
+
+const (
+	// RangePolicyError reports values outside of a signal's Min and Max as a convert.OutOfRangeError.
+	RangePolicyError = "error"
+	// RangePolicyDrop silently drops values outside of a signal's Min and Max.
+	RangePolicyDrop = "drop"
+	// RangePolicyClamp clamps values outside of a signal's Min and Max to the nearest bound.
+	RangePolicyClamp = "clamp"
 )
 
 var (
+	rangePolicies = []string{RangePolicyError, RangePolicyDrop, RangePolicyClamp}
+
 	nonAlphaNum = regexp.MustCompile(`[^a-zA-Z0-9]+`)
 
 	numberTypes = []string{"uint8", "int8", "uint16", "int16", "uint32", "int32", "uint64", "int64", "float", "double", "boolean"}
@@ -39,6 +55,10 @@ type SignalInfo struct {
 	Max        string
 	Desc       string
 	Deprecated bool
+	Comment    string
+	Allowed    []string
+	Default    string
+	ID         string
 
 	// Derived
 	IsArray     bool
@@ -48,6 +68,7 @@ type SignalInfo struct {
 	BaseGQLType string
 	Conversions []*ConversionInfo
 	Privileges  []string
+	RangePolicy string
 }
 
 // ConversionInfo contains the conversion information for a field.
@@ -55,6 +76,29 @@ type ConversionInfo struct {
 	OriginalName string `json:"originalName" yaml:"originalName"`
 	OriginalType string `json:"originalType" yaml:"originalType"`
 	IsArray      bool   `json:"isArray"      yaml:"isArray"`
+	// Unit is the unit of the original value. If set the value is converted to the VSS unit of the signal.
+	Unit string `json:"unit,omitempty" yaml:"unit,omitempty"`
+	// Multiplier scales the original value. Zero means a multiplier of 1.
+	Multiplier float64 `json:"multiplier,omitempty" yaml:"multiplier,omitempty"`
+	// Offset is added to the original value after the multiplier.
+	Offset float64 `json:"offset,omitempty" yaml:"offset,omitempty"`
+	// ErrorValues are raw values that mean the original data has no value for the signal.
+	ErrorValues []int64 `json:"errorValues,omitempty" yaml:"errorValues,omitempty"`
+	// ByteSize is the size of a hex encoded unsigned integer original value.
+	// If set the original value is parsed from hex and the maximum value for the size means the value is not available.
+	ByteSize int `json:"byteSize,omitempty" yaml:"byteSize,omitempty"`
+	// Signed is true if the ByteSize original value is a two's complement signed integer.
+	Signed bool `json:"signed,omitempty" yaml:"signed,omitempty"`
+	// RawMin is the lowest valid raw value of a ByteSize original value. Lower values mean the value is not available.
+	RawMin *int64 `json:"rawMin,omitempty" yaml:"rawMin,omitempty"`
+	// RawMax is the highest valid raw value of a ByteSize original value. Higher values mean the value is not available.
+	RawMax *int64 `json:"rawMax,omitempty" yaml:"rawMax,omitempty"`
+}
+
+// HasLinearTransform returns true if the conversion declares a multiplier, offset, error values or byte size.
+// The signed, rawMin and rawMax fields are only valid with a byte size.
+func (c *ConversionInfo) HasLinearTransform() bool {
+	return c.Multiplier != 0 || c.Offset != 0 || len(c.ErrorValues) != 0 || c.ByteSize != 0
 }
 
 // DefinitionInfo contains the definition information for a field.
@@ -62,6 +106,13 @@ type DefinitionInfo struct {
 	VspecName          string            `json:"vspecName"          yaml:"vspecName"`
 	Conversions        []*ConversionInfo `json:"conversions"        yaml:"conversions"`
 	RequiredPrivileges []string          `json:"requiredPrivileges" yaml:"requiredPrivileges"`
+	// RangePolicy is how values outside of the VSS Min and Max are handled, one of error, drop or clamp.
+	// Ranges are only checked for signals that set a RangePolicy.
+	RangePolicy string `json:"rangePolicy,omitempty" yaml:"rangePolicy,omitempty"`
+	// Min overrides the minimum value from the VSS specification.
+	Min string `json:"min,omitempty" yaml:"min,omitempty"`
+	// Max overrides the maximum value from the VSS specification.
+	Max string `json:"max,omitempty" yaml:"max,omitempty"`
 }
 
 // OriginalNameInfo contains the original name and signals that are derived from it.
@@ -112,6 +163,18 @@ func NewSignalInfo(record []string) *SignalInfo {
 		Max:        record[maxCol],
 		Desc:       record[descCol],
 	}
+	if len(record) > commentCol {
+		sig.Comment = record[commentCol]
+	}
+	if len(record) > allowedCol {
+		sig.Allowed = parseAllowed(record[allowedCol])
+	}
+	if len(record) > defaultCol {
+		sig.Default = record[defaultCol]
+	}
+	if len(record) > idCol {
+		sig.ID = record[idCol]
+	}
 	// arrays are denoted by [] at the end of the type ex uint8[]
 	sig.IsArray = strings.HasSuffix(sig.DataType, "[]")
 	baseType := sig.DataType
@@ -141,6 +204,34 @@ func (s *SignalInfo) MergeWithDefinition(definition *DefinitionInfo) {
 		}
 	}
 	s.Privileges = definition.RequiredPrivileges
+	s.RangePolicy = definition.RangePolicy
+	if definition.Min != "" {
+		s.Min = definition.Min
+	}
+	if definition.Max != "" {
+		s.Max = definition.Max
+	}
+}
+
+// HasRange returns true if the signal is numeric, has a RangePolicy and has a Min or Max defined.
+func (s *SignalInfo) HasRange() bool {
+	return s.RangePolicy != "" && s.GOType() == "float64" && !s.IsArray && (s.Min != "" || s.Max != "")
+}
+
+// RangeMin returns the minimum value of the signal as a Go expression.
+func (s *SignalInfo) RangeMin() string {
+	if s.Min == "" {
+		return "math.Inf(-1)"
+	}
+	return s.Min
+}
+
+// RangeMax returns the maximum value of the signal as a Go expression.
+func (s *SignalInfo) RangeMax() string {
+	if s.Max == "" {
+		return "math.Inf(1)"
+	}
+	return s.Max
 }
 
 // GOType returns the golang type of the signal.
@@ -200,6 +291,21 @@ func splitAndSantizeName(name string) (string, string) {
 	return nonAlphaNum.ReplaceAllString(splitName[0], ""), nonAlphaNum.ReplaceAllString(strings.Join(splitName[1:], ""), "")
 }
 
+// parseAllowed parses the allowed values of a signal from the python list format used by the vspec CSV ex ['A', 'B'].
+func parseAllowed(allowed string) []string {
+	allowed = strings.TrimSpace(allowed)
+	allowed = strings.TrimPrefix(allowed, "[")
+	allowed = strings.TrimSuffix(allowed, "]")
+	if allowed == "" {
+		return nil
+	}
+	values := strings.Split(allowed, ",")
+	for i, val := range values {
+		values[i] = strings.Trim(strings.TrimSpace(val), `'"`)
+	}
+	return values
+}
+
 // goTypeFromVSPEC converts vspec type to golang types.
 func goTypeFromVSPEC(baseType string) string {
 	if slices.Contains(numberTypes, baseType) {
@@ -1,6 +1,10 @@
 package schema
 
-import "testing"
+import (
+	"slices"
+	"strings"
+	"testing"
+)
 
 func TestJSONName(t *testing.T) {
 	tests := []struct {
@@ -54,3 +58,66 @@ func TestJSONName(t *testing.T) {
 		})
 	}
 }
+
+func TestNewSignalInfoOptionalColumns(t *testing.T) {
+	record := []string{
+		"Vehicle.Powertrain.Type", "attribute", "string", "", "", "", "", "Defines the powertrain type of the vehicle.",
+		"For vehicles with a combustion engine.", "['COMBUSTION', 'HYBRID', 'ELECTRIC']", "COMBUSTION", "2a000da4204658a4a6e3ecd5176bdfba",
+	}
+	sig := NewSignalInfo(record)
+	if sig.Comment != "For vehicles with a combustion engine." {
+		t.Errorf("Unexpected comment: %s", sig.Comment)
+	}
+	if !slices.Equal(sig.Allowed, []string{"COMBUSTION", "HYBRID", "ELECTRIC"}) {
+		t.Errorf("Unexpected allowed values: %v", sig.Allowed)
+	}
+	if sig.Default != "COMBUSTION" {
+		t.Errorf("Unexpected default: %s", sig.Default)
+	}
+	if sig.ID != "2a000da4204658a4a6e3ecd5176bdfba" {
+		t.Errorf("Unexpected id: %s", sig.ID)
+	}
+
+	sig = NewSignalInfo(record[:colLen])
+	if sig.Comment != "" || sig.Allowed != nil || sig.Default != "" || sig.ID != "" {
+		t.Errorf("Expected optional columns to be empty got: %+v", sig)
+	}
+}
+
+func TestParseAllowed(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected []string
+	}{
+		{input: "", expected: nil},
+		{input: "[]", expected: nil},
+		{input: "['LOW']", expected: []string{"LOW"}},
+		{input: "['CRITICALLY_LOW', 'LOW', 'NORMAL']", expected: []string{"CRITICALLY_LOW", "LOW", "NORMAL"}},
+		{input: `["A","B"]`, expected: []string{"A", "B"}},
+	}
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			result := parseAllowed(test.input)
+			if !slices.Equal(result, test.expected) {
+				t.Errorf("Unexpected result. Expected: %v, Got: %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestVssRel42DIMOOverlay(t *testing.T) {
+	signals, err := LoadSignalsCSV(strings.NewReader(VssRel42DIMO()))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	idx := slices.IndexFunc(signals, func(sig *SignalInfo) bool { return sig.Name == "Vehicle.DIMO.Aftermarket.SupplyVoltage" })
+	if idx == -1 {
+		t.Fatal("Expected overlay signal to be merged into the spec")
+	}
+	if signals[idx].Unit != "V" || signals[idx].DataType != "float" {
+		t.Errorf("Unexpected overlay signal: %+v", signals[idx])
+	}
+	if !slices.IsSortedFunc(signals, func(a, b *SignalInfo) int { return strings.Compare(a.Name, b.Name) }) {
+		t.Error("Expected merged signals to be sorted by name")
+	}
+}
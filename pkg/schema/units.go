@@ -0,0 +1,147 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// unitInfo describes how to convert a unit into the base unit of its quantity.
+// base = value*multiplier + offset.
+type unitInfo struct {
+	quantity   string
+	multiplier float64
+	offset     float64
+}
+
+// units is the registry of known units keyed by their VSS unit name.
+var units = map[string]unitInfo{
+	// length, base m
+	"mm":   {quantity: "length", multiplier: 0.001},
+	"cm":   {quantity: "length", multiplier: 0.01},
+	"m":    {quantity: "length", multiplier: 1},
+	"km":   {quantity: "length", multiplier: 1000},
+	"inch": {quantity: "length", multiplier: 0.0254},
+	"ft":   {quantity: "length", multiplier: 0.3048},
+	"mi":   {quantity: "length", multiplier: 1609.344},
+
+	// speed, base km/h
+	"km/h": {quantity: "speed", multiplier: 1},
+	"m/s":  {quantity: "speed", multiplier: 3.6},
+	"mph":  {quantity: "speed", multiplier: 1.609344},
+
+	// pressure, base kPa
+	"Pa":   {quantity: "pressure", multiplier: 0.001},
+	"kPa":  {quantity: "pressure", multiplier: 1},
+	"mbar": {quantity: "pressure", multiplier: 0.1},
+	"bar":  {quantity: "pressure", multiplier: 100},
+	"psi":  {quantity: "pressure", multiplier: 6.894757293168361},
+
+	// temperature, base celsius
+	"celsius":    {quantity: "temperature", multiplier: 1},
+	"fahrenheit": {quantity: "temperature", multiplier: 5.0 / 9.0, offset: -160.0 / 9.0},
+	"K":          {quantity: "temperature", multiplier: 1, offset: -273.15},
+
+	// power, base W
+	"W":  {quantity: "power", multiplier: 1},
+	"kW": {quantity: "power", multiplier: 1000},
+
+	// energy, base kWh
+	"Wh":  {quantity: "energy", multiplier: 0.001},
+	"kWh": {quantity: "energy", multiplier: 1},
+
+	// electric potential, base V
+	"mV": {quantity: "voltage", multiplier: 0.001},
+	"V":  {quantity: "voltage", multiplier: 1},
+
+	// electric current, base A
+	"mA": {quantity: "current", multiplier: 0.001},
+	"A":  {quantity: "current", multiplier: 1},
+
+	// volume, base l
+	"ml":  {quantity: "volume", multiplier: 0.001},
+	"l":   {quantity: "volume", multiplier: 1},
+	"gal": {quantity: "volume", multiplier: 3.785411784},
+
+	// mass, base kg
+	"g":  {quantity: "mass", multiplier: 0.001},
+	"kg": {quantity: "mass", multiplier: 1},
+	"lb": {quantity: "mass", multiplier: 0.45359237},
+
+	// time, base s
+	"ms":  {quantity: "time", multiplier: 0.001},
+	"s":   {quantity: "time", multiplier: 1},
+	"min": {quantity: "time", multiplier: 60},
+	"h":   {quantity: "time", multiplier: 3600},
+}
+
+// UnitError is returned when a unit is unknown or cannot be converted to another unit.
+type UnitError struct {
+	From   string
+	To     string
+	Reason string
+}
+
+func (e UnitError) Error() string {
+	return fmt.Sprintf("cannot convert unit '%s' to '%s': %s", e.From, e.To, e.Reason)
+}
+
+// IsKnownUnit returns true if the unit is in the unit registry.
+func IsKnownUnit(unit string) bool {
+	_, ok := units[unit]
+	return ok
+}
+
+// UnitConversion returns the multiplier and offset that convert a value from one unit to another.
+// converted = value*multiplier + offset.
+func UnitConversion(from, to string) (multiplier, offset float64, err error) {
+	fromInfo, ok := units[from]
+	if !ok {
+		return 0, 0, UnitError{From: from, To: to, Reason: fmt.Sprintf("unknown unit '%s'", from)}
+	}
+	toInfo, ok := units[to]
+	if !ok {
+		return 0, 0, UnitError{From: from, To: to, Reason: fmt.Sprintf("unknown unit '%s'", to)}
+	}
+	if fromInfo.quantity != toInfo.quantity {
+		return 0, 0, UnitError{From: from, To: to, Reason: fmt.Sprintf("'%s' is a %s unit and '%s' is a %s unit", from, fromInfo.quantity, to, toInfo.quantity)}
+	}
+	multiplier = fromInfo.multiplier / toInfo.multiplier
+	offset = (fromInfo.offset - toInfo.offset) / toInfo.multiplier
+	return multiplier, offset, nil
+}
+
+// UnitConversionExpr returns a Go expression that converts the expression expr from one unit to another.
+func UnitConversionExpr(expr, from, to string) (string, error) {
+	multiplier, offset, err := UnitConversion(from, to)
+	if err != nil {
+		return "", err
+	}
+	return LinearExpr(expr, multiplier, offset), nil
+}
+
+// LinearExpr returns a Go expression for expr*multiplier + offset.
+// Multipliers that are the reciprocal of an integer are written as a division so that, for example, val / 10 is not printed as val * 0.1.
+// Other factors are written with 15 significant digits so that exact conversions such as mi to km are not printed with float noise.
+func LinearExpr(expr string, multiplier, offset float64) string {
+	if multiplier != 1 && strings.Contains(expr, " ") {
+		expr = "(" + expr + ")"
+	}
+	if divisor := 1 / multiplier; multiplier != 1 && math.Abs(multiplier) < 1 && math.Abs(divisor-math.Round(divisor)) < 1e-6 {
+		expr += " / " + formatFactor(math.Round(divisor))
+	} else if multiplier != 1 {
+		expr += " * " + formatFactor(multiplier)
+	}
+	switch {
+	case offset > 0:
+		expr += " + " + formatFactor(offset)
+	case offset < 0:
+		expr += " - " + formatFactor(-offset)
+	}
+	return expr
+}
+
+func formatFactor(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
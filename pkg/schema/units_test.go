@@ -0,0 +1,100 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitConversion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		val      float64
+		expected float64
+	}{
+		{name: "bar to kPa", from: "bar", to: "kPa", val: 3.12, expected: 312},
+		{name: "psi to kPa", from: "psi", to: "kPa", val: 32, expected: 220.632},
+		{name: "mi to km", from: "mi", to: "km", val: 100, expected: 160.9344},
+		{name: "mph to km/h", from: "mph", to: "km/h", val: 60, expected: 96.56064},
+		{name: "fahrenheit to celsius", from: "fahrenheit", to: "celsius", val: 212, expected: 100},
+		{name: "celsius to fahrenheit", from: "celsius", to: "fahrenheit", val: -40, expected: -40},
+		{name: "K to celsius", from: "K", to: "celsius", val: 0, expected: -273.15},
+		{name: "kW to W", from: "kW", to: "W", val: 1.5, expected: 1500},
+		{name: "same unit", from: "km", to: "km", val: 7, expected: 7},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			multiplier, offset, err := schema.UnitConversion(tt.from, tt.to)
+			require.NoError(t, err)
+			require.InDelta(t, tt.expected, tt.val*multiplier+offset, 1e-3)
+		})
+	}
+}
+
+func TestUnitConversionErrors(t *testing.T) {
+	t.Parallel()
+	var unitErr schema.UnitError
+	_, _, err := schema.UnitConversion("furlong", "km")
+	require.ErrorAs(t, err, &unitErr)
+	_, _, err = schema.UnitConversion("km", "furlong")
+	require.ErrorAs(t, err, &unitErr)
+	_, _, err = schema.UnitConversion("km", "kPa")
+	require.ErrorAs(t, err, &unitErr)
+}
+
+func TestUnitConversionExpr(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		from     string
+		to       string
+		expected string
+	}{
+		{from: "km", to: "km", expected: "val"},
+		{from: "bar", to: "kPa", expected: "val * 100"},
+		{from: "mi", to: "km", expected: "val * 1.609344"},
+		{from: "m", to: "km", expected: "val / 1000"},
+		{from: "K", to: "celsius", expected: "val - 273.15"},
+		{from: "celsius", to: "K", expected: "val + 273.15"},
+		{from: "fahrenheit", to: "celsius", expected: "val * 0.5555555555555556 - 17.77777777777778"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			t.Parallel()
+			expr, err := schema.UnitConversionExpr("val", tt.from, tt.to)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, expr)
+		})
+	}
+}
+
+func TestLinearExpr(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		expr       string
+		multiplier float64
+		offset     float64
+		expected   string
+	}{
+		{name: "identity", expr: "val", multiplier: 1, expected: "val"},
+		{name: "multiplier", expr: "val", multiplier: 0.39215686274509803, expected: "val * 0.39215686274509803"},
+		{name: "divisor", expr: "val", multiplier: 0.0000001, expected: "val / 10000000"},
+		{name: "offset", expr: "float64(rawInt)", multiplier: 1, offset: -40, expected: "float64(rawInt) - 40"},
+		{name: "multiplier and offset", expr: "val", multiplier: 0.1, offset: 5, expected: "val / 10 + 5"},
+		{name: "nested", expr: "val - 40", multiplier: 1.8, offset: 32, expected: "(val - 40) * 1.8 + 32"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expected, schema.LinearExpr(tt.expr, tt.multiplier, tt.offset))
+		})
+	}
+}
@@ -3,11 +3,20 @@ package schema
 import (
 	"fmt"
 	"slices"
+	"strconv"
 )
 
 // privileges are defined on chain and copied here for validation.
 var privileges = []string{"VEHICLE_NON_LOCATION_DATA", "VEHICLE_COMMANDS", "VEHICLE_CURRENT_LOCATION", "VEHICLE_ALL_TIME_LOCATION", "VEHICLE_VIN_CREDENTIAL"}
 
+// Privileges returns the privileges that can be required by a definition.
+func Privileges() []string {
+	return slices.Clone(privileges)
+}
+
+// maxByteSize is the largest byteSize that fits in a uint64.
+const maxByteSize = 8
+
 // InvalidError is an error for invalid definitions.
 type InvalidError struct {
 	Property string
@@ -34,11 +43,44 @@ func Validate(d *DefinitionInfo) error {
 		if conv.OriginalName == "" {
 			return InvalidError{Property: "originalName", Name: d.VspecName, Reason: "is empty"}
 		}
+		if conv.Unit != "" && !IsKnownUnit(conv.Unit) {
+			return InvalidError{Property: "unit", Name: d.VspecName, Reason: fmt.Sprintf("'%s' is not a known unit", conv.Unit)}
+		}
+		if conv.ByteSize < 0 || conv.ByteSize > maxByteSize {
+			return InvalidError{Property: "byteSize", Name: d.VspecName, Reason: fmt.Sprintf("must be between 0 and %d", maxByteSize)}
+		}
+		if conv.ByteSize != 0 && !conv.Signed && slices.ContainsFunc(conv.ErrorValues, func(v int64) bool { return v < 0 }) {
+			return InvalidError{Property: "errorValues", Name: d.VspecName, Reason: "must not be negative for unsigned byteSize values"}
+		}
+		if conv.ByteSize == 0 && conv.Signed {
+			return InvalidError{Property: "signed", Name: d.VspecName, Reason: "requires byteSize"}
+		}
+		if conv.ByteSize == 0 && conv.RawMin != nil {
+			return InvalidError{Property: "rawMin", Name: d.VspecName, Reason: "requires byteSize"}
+		}
+		if conv.ByteSize == 0 && conv.RawMax != nil {
+			return InvalidError{Property: "rawMax", Name: d.VspecName, Reason: "requires byteSize"}
+		}
+		if !conv.Signed && conv.RawMin != nil && *conv.RawMin < 0 {
+			return InvalidError{Property: "rawMin", Name: d.VspecName, Reason: "must not be negative for unsigned byteSize values"}
+		}
+		if conv.RawMin != nil && conv.RawMax != nil && *conv.RawMin > *conv.RawMax {
+			return InvalidError{Property: "rawMin", Name: d.VspecName, Reason: "must not be greater than rawMax"}
+		}
 	}
 	for _, priv := range d.RequiredPrivileges {
 		if !slices.Contains(privileges, priv) {
 			return InvalidError{Property: "requiredPrivileges", Name: d.VspecName, Reason: fmt.Sprintf("must be one of %v", privileges)}
 		}
 	}
+	if _, err := strconv.ParseFloat(d.Min, 64); d.Min != "" && err != nil {
+		return InvalidError{Property: "min", Name: d.VspecName, Reason: "must be a number"}
+	}
+	if _, err := strconv.ParseFloat(d.Max, 64); d.Max != "" && err != nil {
+		return InvalidError{Property: "max", Name: d.VspecName, Reason: "must be a number"}
+	}
+	if d.RangePolicy != "" && !slices.Contains(rangePolicies, d.RangePolicy) {
+		return InvalidError{Property: "rangePolicy", Name: d.VspecName, Reason: fmt.Sprintf("must be one of %v", rangePolicies)}
+	}
 	return nil
 }
@@ -78,6 +78,116 @@ func TestValidate(t *testing.T) {
 				Reason:   "must be one of [VEHICLE_NON_LOCATION_DATA VEHICLE_COMMANDS VEHICLE_CURRENT_LOCATION VEHICLE_ALL_TIME_LOCATION VEHICLE_VIN_CREDENTIAL]",
 			},
 		},
+		{
+			name: "Valid RangePolicy",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName"}},
+				RangePolicy: RangePolicyClamp,
+			},
+			expected: nil,
+		},
+		{
+			name: "Invalid RangePolicy",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName"}},
+				RangePolicy: "ignore",
+			},
+			expected: InvalidError{
+				Property: "rangePolicy",
+				Name:     "Vehicle",
+				Reason:   "must be one of [error drop clamp]",
+			},
+		},
+		{
+			name: "Valid Unit",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName", Unit: "psi"}},
+			},
+			expected: nil,
+		},
+		{
+			name: "Unknown Unit",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName", Unit: "furlong"}},
+			},
+			expected: InvalidError{
+				Property: "unit",
+				Name:     "Vehicle",
+				Reason:   "'furlong' is not a known unit",
+			},
+		},
+		{
+			name: "Invalid ByteSize",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName", ByteSize: 9}},
+			},
+			expected: InvalidError{
+				Property: "byteSize",
+				Name:     "Vehicle",
+				Reason:   "must be between 0 and 8",
+			},
+		},
+		{
+			name: "Negative ErrorValues with ByteSize",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName", ByteSize: 2, ErrorValues: []int64{-1}}},
+			},
+			expected: InvalidError{
+				Property: "errorValues",
+				Name:     "Vehicle",
+				Reason:   "must not be negative for unsigned byteSize values",
+			},
+		},
+		{
+			name: "Negative ErrorValues with signed ByteSize",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName", ByteSize: 1, Signed: true, ErrorValues: []int64{-1}}},
+			},
+			expected: nil,
+		},
+		{
+			name: "Signed without ByteSize",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName", Signed: true}},
+			},
+			expected: InvalidError{
+				Property: "signed",
+				Name:     "Vehicle",
+				Reason:   "requires byteSize",
+			},
+		},
+		{
+			name: "RawMax without ByteSize",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName", RawMax: ptr(int64(250))}},
+			},
+			expected: InvalidError{
+				Property: "rawMax",
+				Name:     "Vehicle",
+				Reason:   "requires byteSize",
+			},
+		},
+		{
+			name: "RawMin greater than RawMax",
+			d: &DefinitionInfo{
+				VspecName:   "Vehicle",
+				Conversions: []*ConversionInfo{{OriginalName: "OriginalName", ByteSize: 1, RawMin: ptr(int64(10)), RawMax: ptr(int64(5))}},
+			},
+			expected: InvalidError{
+				Property: "rawMin",
+				Name:     "Vehicle",
+				Reason:   "must not be greater than rawMax",
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -89,3 +199,7 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -4,6 +4,7 @@ package status
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 
 	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
 	"github.com/DIMO-Network/model-garage/pkg/convert"
@@ -19,12 +20,11 @@ func Decode(msgBytes []byte) ([]vss.Signal, error) {
 		return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
 	}
 
-	did, err := cloudevent.DecodeNFTDID(ce.Subject)
+	tokenID, err := cloudevent.TokenIDFromDID(ce.Subject)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode subject DID: %w", err)
 	}
 
-	tokenID := did.TokenID
 	source := ce.Source
 
 	baseSignal := vss.Signal{
@@ -44,3 +44,9 @@ func Decode(msgBytes []byte) ([]vss.Signal, error) {
 
 	return sigs, nil
 }
+
+// DecodeBatch decodes each Tesla CloudEvent of a batch into a slice of signals.
+// Errors for a single event are returned with its signals, the error is only set if the batch is malformed.
+func DecodeBatch(r io.Reader) ([]convert.EventSignals, error) {
+	return convert.DecodeBatch(r, Decode)
+}
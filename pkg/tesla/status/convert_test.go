@@ -1,6 +1,7 @@
 package status
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -47,17 +48,17 @@ var baseDoc = []byte(`
 const teslaConnection = "0x983110309620D911731Ac0932219af06091b6744"
 
 var expSignals = []vss.Signal{
-	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "chassisAxleRow1WheelLeftTirePressure", ValueNumber: 312, Source: teslaConnection},
-	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "chassisAxleRow1WheelRightTirePressure", ValueNumber: 309, Source: teslaConnection},
-	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "chassisAxleRow2WheelLeftTirePressure", ValueNumber: 298, Source: teslaConnection},
-	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "chassisAxleRow2WheelRightTirePressure", ValueNumber: 299, Source: teslaConnection},
+	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "chassisAxleRow1WheelLeftTirePressure", ValueNumber: 312, ValueInt: 312, Source: teslaConnection},
+	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "chassisAxleRow1WheelRightTirePressure", ValueNumber: 309, ValueInt: 309, Source: teslaConnection},
+	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "chassisAxleRow2WheelLeftTirePressure", ValueNumber: 298, ValueInt: 298, Source: teslaConnection},
+	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "chassisAxleRow2WheelRightTirePressure", ValueNumber: 299, ValueInt: 299, Source: teslaConnection},
 	{TokenID: 37, Timestamp: time.UnixMilli(1730738800000), Name: "currentLocationLatitude", ValueNumber: 38.89, Source: teslaConnection},
 	{TokenID: 37, Timestamp: time.UnixMilli(1730738800000), Name: "currentLocationLongitude", ValueNumber: 77.03, Source: teslaConnection},
 	{TokenID: 37, Timestamp: time.UnixMilli(1730728802000), Name: "exteriorAirTemperature", ValueNumber: 19, Source: teslaConnection},
 	{TokenID: 37, Timestamp: time.UnixMilli(1730728800000), Name: "powertrainRange", ValueNumber: 548.7863040000001, Source: teslaConnection},
 	{TokenID: 37, Timestamp: time.UnixMilli(1730728800000), Name: "powertrainTractionBatteryChargingAddedEnergy", ValueNumber: 42, Source: teslaConnection},
-	{TokenID: 37, Timestamp: time.UnixMilli(1730728800000), Name: "powertrainTractionBatteryChargingChargeLimit", ValueNumber: 80, Source: teslaConnection},
-	{TokenID: 37, Timestamp: time.UnixMilli(1730728800000), Name: "powertrainTractionBatteryChargingIsCharging", ValueNumber: 1, Source: teslaConnection},
+	{TokenID: 37, Timestamp: time.UnixMilli(1730728800000), Name: "powertrainTractionBatteryChargingChargeLimit", ValueNumber: 80, ValueInt: 80, Source: teslaConnection},
+	{TokenID: 37, Timestamp: time.UnixMilli(1730728800000), Name: "powertrainTractionBatteryChargingIsCharging", ValueNumber: 1, ValueBool: true, Source: teslaConnection},
 	{TokenID: 37, Timestamp: time.UnixMilli(1730738800000), Name: "powertrainTractionBatteryCurrentPower", ValueNumber: 7000, Source: teslaConnection},
 	{TokenID: 37, Timestamp: time.UnixMilli(1730728800000), Name: "powertrainTractionBatteryStateOfChargeCurrent", ValueNumber: 23, Source: teslaConnection},
 	{TokenID: 37, Timestamp: time.UnixMilli(1730728805000), Name: "powertrainTransmissionTravelledDistance", ValueNumber: 9065.434752000001, Source: teslaConnection},
@@ -69,3 +70,18 @@ func TestSignalsFromTesla(t *testing.T) {
 	require.Empty(t, err, "Expected no errors.")
 	assert.ElementsMatch(t, computedSignals, expSignals)
 }
+
+func TestDecodeBatch(t *testing.T) {
+	batch := bytes.Join([][]byte{[]byte("["), baseDoc, []byte(`,{"subject":"did:web:example.com","data":{}}]`)}, nil)
+	results, err := DecodeBatch(bytes.NewReader(batch))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.NoError(t, results[0].Err)
+	assert.ElementsMatch(t, results[0].Signals, expSignals)
+	require.Equal(t, 1, results[1].Index)
+	require.Error(t, results[1].Err)
+	require.Empty(t, results[1].Signals)
+
+	_, err = DecodeBatch(bytes.NewReader(baseDoc))
+	require.Error(t, err, "a single event is not a batch")
+}
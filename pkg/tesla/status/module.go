@@ -0,0 +1,50 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/DIMO-Network/model-garage/pkg/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/modules"
+	"github.com/DIMO-Network/model-garage/pkg/tesla/fingerprint"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+)
+
+// ModuleName is the name the Tesla module is registered under.
+// Tesla events carry no data version, so their sources must be routed with modules.RegisterSource.
+const ModuleName = "tesla"
+
+func init() {
+	modules.Register(ModuleName, Module{})
+}
+
+// Module is the Tesla source module.
+type Module struct{}
+
+// SignalConvert converts a Tesla CloudEvent into a slice of signals.
+func (Module) SignalConvert(_ context.Context, msgData []byte) ([]vss.Signal, error) {
+	return Decode(msgData)
+}
+
+// FingerprintConvert converts a Tesla CloudEvent into a fingerprint.
+func (Module) FingerprintConvert(_ context.Context, msgData []byte) (cloudevent.Fingerprint, error) {
+	var event cloudevent.CloudEvent[json.RawMessage]
+	if err := json.Unmarshal(msgData, &event); err != nil {
+		return cloudevent.Fingerprint{}, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+	return fingerprint.DecodeFingerprintFromData(event.Data)
+}
+
+// EventConvert is not supported by Tesla.
+func (Module) EventConvert(context.Context, []byte) ([]vss.Event, error) {
+	return nil, modules.UnsupportedError{Module: ModuleName, Operation: modules.OperationEvents}
+}
+
+// CloudEventConvert returns the message unchanged since Tesla messages are already CloudEvents.
+func (Module) CloudEventConvert(_ context.Context, msgData []byte) ([][]byte, error) {
+	if !json.Valid(msgData) {
+		return nil, fmt.Errorf("message is not valid JSON")
+	}
+	return [][]byte{msgData}, nil
+}
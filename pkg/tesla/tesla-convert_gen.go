@@ -4,6 +4,7 @@ package tesla
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
@@ -12,7 +13,7 @@ import (
 	"github.com/tidwall/gjson"
 )
 
-var errNotFound = errors.New("field not found")
+var errNotFound = convert.ErrNotFound
 
 // SignalsFromV1Data creates a slice of vss.Signal from the given v1 status JSON data.
 // On error, partial results may be returned.
@@ -36,8 +37,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow1WheelLeftTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = ChassisAxleRow1WheelRightTirePressureFromTesla(jsonData)
@@ -52,8 +56,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow1WheelRightTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = ChassisAxleRow2WheelLeftTirePressureFromTesla(jsonData)
@@ -68,8 +75,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow2WheelLeftTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = ChassisAxleRow2WheelRightTirePressureFromTesla(jsonData)
@@ -84,8 +94,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint16", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ChassisAxleRow2WheelRightTirePressure': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = CurrentLocationLatitudeFromTesla(jsonData)
@@ -100,8 +113,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("double", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationLatitude': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = CurrentLocationLongitudeFromTesla(jsonData)
@@ -116,8 +132,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("double", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'CurrentLocationLongitude': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = ExteriorAirTemperatureFromTesla(jsonData)
@@ -132,8 +151,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'ExteriorAirTemperature': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = PowertrainRangeFromTesla(jsonData)
@@ -148,8 +170,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint32", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainRange': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = PowertrainTractionBatteryChargingAddedEnergyFromTesla(jsonData)
@@ -164,8 +189,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryChargingAddedEnergy': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = PowertrainTractionBatteryChargingChargeLimitFromTesla(jsonData)
@@ -180,8 +208,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("uint8", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryChargingChargeLimit': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = PowertrainTractionBatteryChargingIsChargingFromTesla(jsonData)
@@ -196,8 +227,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("boolean", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryChargingIsCharging': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = PowertrainTractionBatteryCurrentPowerFromTesla(jsonData)
@@ -212,8 +246,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryCurrentPower': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = PowertrainTractionBatteryStateOfChargeCurrentFromTesla(jsonData)
@@ -228,8 +265,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTractionBatteryStateOfChargeCurrent': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = PowertrainTransmissionTravelledDistanceFromTesla(jsonData)
@@ -244,8 +284,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'PowertrainTransmissionTravelledDistance': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 
 	val, ts, err = SpeedFromTesla(jsonData)
@@ -260,8 +303,11 @@ func SignalsFromTesla(baseSignal vss.Signal, jsonData []byte) ([]vss.Signal, []e
 			Timestamp: ts,
 			Source:    baseSignal.Source,
 		}
-		sig.SetValue(val)
-		retSignals = append(retSignals, sig)
+		if err := sig.SetTypedValue("float", val); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set 'Speed': %w", err))
+		} else {
+			retSignals = append(retSignals, sig)
+		}
 	}
 	return retSignals, errs
 }
@@ -409,6 +455,9 @@ func CurrentLocationLatitudeFromTesla(jsonData []byte) (ret float64, ts time.Tim
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToCurrentLocationLatitude0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, -90, 90)
+			}
 			if err == nil {
 				endpoint, _, _ := strings.Cut("drive_state.latitude", ".")
 				result := gjson.GetBytes(jsonData, "data."+endpoint+".timestamp")
@@ -442,6 +491,9 @@ func CurrentLocationLongitudeFromTesla(jsonData []byte) (ret float64, ts time.Ti
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToCurrentLocationLongitude0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, -180, 180)
+			}
 			if err == nil {
 				endpoint, _, _ := strings.Cut("drive_state.longitude", ".")
 				result := gjson.GetBytes(jsonData, "data."+endpoint+".timestamp")
@@ -706,6 +758,9 @@ func PowertrainTransmissionTravelledDistanceFromTesla(jsonData []byte) (ret floa
 		val, ok := result.Value().(float64)
 		if ok {
 			retVal, err := ToPowertrainTransmissionTravelledDistance0(jsonData, val)
+			if err == nil {
+				retVal, err = convert.ApplyRange("error", val, retVal, 0, math.Inf(1))
+			}
 			if err == nil {
 				endpoint, _, _ := strings.Cut("vehicle_state.odometer", ".")
 				result := gjson.GetBytes(jsonData, "data."+endpoint+".timestamp")
@@ -2,10 +2,6 @@ package tesla
 
 const kilometersPerMile = 1.609344
 
-func barsToKilopascals(bars float64) float64 {
-	return 100 * bars
-}
-
 func milesToKilometers(miles float64) float64 {
 	return kilometersPerMile * miles
 }
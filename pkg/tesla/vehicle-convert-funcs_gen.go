@@ -9,28 +9,28 @@ package tesla
 // Vehicle.Chassis.Axle.Row1.Wheel.Left.Tire.Pressure: Tire pressure in kilo-Pascal.
 // Unit: 'kPa'
 func ToChassisAxleRow1WheelLeftTirePressure0(originalDoc []byte, val float64) (float64, error) {
-	return barsToKilopascals(val), nil
+	return val * 100, nil
 }
 
 // ToChassisAxleRow1WheelRightTirePressure0 converts data from field 'vehicle_state.tpms_pressure_fr' of type float64 to 'Vehicle.Chassis.Axle.Row1.Wheel.Right.Tire.Pressure' of type float64.
 // Vehicle.Chassis.Axle.Row1.Wheel.Right.Tire.Pressure: Tire pressure in kilo-Pascal.
 // Unit: 'kPa'
 func ToChassisAxleRow1WheelRightTirePressure0(originalDoc []byte, val float64) (float64, error) {
-	return barsToKilopascals(val), nil
+	return val * 100, nil
 }
 
 // ToChassisAxleRow2WheelLeftTirePressure0 converts data from field 'vehicle_state.tpms_pressure_rl' of type float64 to 'Vehicle.Chassis.Axle.Row2.Wheel.Left.Tire.Pressure' of type float64.
 // Vehicle.Chassis.Axle.Row2.Wheel.Left.Tire.Pressure: Tire pressure in kilo-Pascal.
 // Unit: 'kPa'
 func ToChassisAxleRow2WheelLeftTirePressure0(originalDoc []byte, val float64) (float64, error) {
-	return barsToKilopascals(val), nil
+	return val * 100, nil
 }
 
 // ToChassisAxleRow2WheelRightTirePressure0 converts data from field 'vehicle_state.tpms_pressure_rr' of type float64 to 'Vehicle.Chassis.Axle.Row2.Wheel.Right.Tire.Pressure' of type float64.
 // Vehicle.Chassis.Axle.Row2.Wheel.Right.Tire.Pressure: Tire pressure in kilo-Pascal.
 // Unit: 'kPa'
 func ToChassisAxleRow2WheelRightTirePressure0(originalDoc []byte, val float64) (float64, error) {
-	return barsToKilopascals(val), nil
+	return val * 100, nil
 }
 
 // ToCurrentLocationLatitude0 converts data from field 'drive_state.latitude' of type float64 to 'Vehicle.CurrentLocation.Latitude' of type float64.
@@ -106,14 +106,14 @@ func ToPowertrainTractionBatteryStateOfChargeCurrent0(originalDoc []byte, val fl
 
 // ToPowertrainTransmissionTravelledDistance0 converts data from field 'vehicle_state.odometer' of type float64 to 'Vehicle.Powertrain.Transmission.TravelledDistance' of type float64.
 // Vehicle.Powertrain.Transmission.TravelledDistance: Odometer reading, total distance travelled during the lifetime of the transmission.
-// Unit: 'km'
+// Unit: 'km' Min: '0'
 func ToPowertrainTransmissionTravelledDistance0(originalDoc []byte, val float64) (float64, error) {
-	return milesToKilometers(val), nil
+	return val * 1.609344, nil
 }
 
 // ToSpeed0 converts data from field 'drive_state.speed' of type float64 to 'Vehicle.Speed' of type float64.
 // Vehicle.Speed: Vehicle speed.
 // Unit: 'km/h'
 func ToSpeed0(originalDoc []byte, val float64) (float64, error) {
-	return milesToKilometers(val), nil
+	return val * 1.609344, nil
 }
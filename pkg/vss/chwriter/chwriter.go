@@ -0,0 +1,309 @@
+// Package chwriter batches vss.Signal values into the ClickHouse signal table.
+package chwriter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+)
+
+const (
+	// DefaultBatchSize is the default number of signals sent in a single insert.
+	DefaultBatchSize = 10_000
+	// DefaultFlushInterval is the default maximum time a signal waits before it is sent.
+	DefaultFlushInterval = time.Second
+	// DefaultMaxRetries is the default number of times a failed insert is retried.
+	DefaultMaxRetries = 5
+	// DefaultRetryBackoff is the default wait before the first retry, it doubles after each retry.
+	DefaultRetryBackoff = 100 * time.Millisecond
+	// DefaultMaxRetryBackoff is the default maximum wait between retries.
+	DefaultMaxRetryBackoff = 10 * time.Second
+)
+
+// ErrClosed is returned when writing to a closed Writer.
+var ErrClosed = errors.New("writer is closed")
+
+// Conn is the part of a clickhouse connection used by the Writer.
+type Conn interface {
+	PrepareBatch(ctx context.Context, query string, opts ...driver.PrepareBatchOption) (driver.Batch, error)
+}
+
+// Config is the configuration for a Writer.
+// Zero values are replaced with the defaults.
+type Config struct {
+	// BatchSize is the number of signals that triggers a flush.
+	BatchSize int
+	// FlushInterval is the maximum time a signal is buffered before it is flushed.
+	FlushInterval time.Duration
+	// QueueSize is the number of signals that can be queued before Write blocks.
+	// Defaults to BatchSize.
+	QueueSize int
+	// MaxRetries is the number of times a failed insert is retried before the batch is dropped.
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry, it doubles after each retry up to MaxRetryBackoff.
+	RetryBackoff time.Duration
+	// MaxRetryBackoff is the maximum wait between retries.
+	MaxRetryBackoff time.Duration
+	// OnError is called with the error and the signals of a batch that was dropped after all retries failed.
+	// It is called from the flushing goroutine and must not block.
+	OnError func(err error, signals []vss.Signal)
+}
+
+// Writer buffers signals and inserts them into the signal table in batches.
+// Signals are flushed when BatchSize signals are buffered or FlushInterval has passed.
+// Write blocks when QueueSize signals are waiting, so producers slow down when ClickHouse does.
+type Writer struct {
+	conn  Conn
+	cfg   Config
+	query string
+
+	queue    chan vss.Signal
+	flushReq chan chan error
+	done     chan struct{}
+	stopped  chan struct{}
+
+	// ctx is canceled when Close gives up on flushing so that retries stop.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// mu guards closed and keeps Close from finishing while a Write is queuing signals.
+	mu     sync.RWMutex
+	closed bool
+}
+
+// New creates a Writer and starts its flushing goroutine.
+// Close must be called to flush the remaining signals and stop the goroutine.
+func New(conn Conn, cfg Config) *Writer {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = DefaultFlushInterval
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = cfg.BatchSize
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	} else if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = DefaultRetryBackoff
+	}
+	if cfg.MaxRetryBackoff <= 0 {
+		cfg.MaxRetryBackoff = DefaultMaxRetryBackoff
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &Writer{
+		conn:     conn,
+		cfg:      cfg,
+		query:    fmt.Sprintf("INSERT INTO %s (%s)", vss.TableName, strings.Join(vss.SignalColNames(), ", ")),
+		queue:    make(chan vss.Signal, cfg.QueueSize),
+		flushReq: make(chan chan error),
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+	go w.run()
+	return w
+}
+
+// Write queues signals to be inserted.
+// It blocks while the queue is full and returns the context error if ctx is done first,
+// in which case some of the signals may already be queued.
+func (w *Writer) Write(ctx context.Context, signals ...vss.Signal) error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return ErrClosed
+	}
+	for i := range signals {
+		select {
+		case w.queue <- signals[i]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Flush inserts all queued signals and returns the error of the insert, if any.
+func (w *Writer) Flush(ctx context.Context) error {
+	w.mu.RLock()
+	if w.closed {
+		w.mu.RUnlock()
+		return ErrClosed
+	}
+	errCh := make(chan error, 1)
+	select {
+	case w.flushReq <- errCh:
+	case <-ctx.Done():
+		w.mu.RUnlock()
+		return ctx.Err()
+	}
+	w.mu.RUnlock()
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close flushes the queued signals and stops the Writer.
+// If ctx is done before the flush finishes, pending retries are canceled and the context error is returned.
+func (w *Writer) Close(ctx context.Context) error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return ErrClosed
+	}
+	w.closed = true
+	w.mu.Unlock()
+	close(w.done)
+
+	select {
+	case <-w.stopped:
+		return nil
+	case <-ctx.Done():
+		w.cancel()
+		<-w.stopped
+		return ctx.Err()
+	}
+}
+
+// run buffers queued signals and flushes them until the Writer is closed.
+func (w *Writer) run() {
+	defer close(w.stopped)
+	defer w.cancel()
+	ticker := time.NewTicker(w.cfg.FlushInterval)
+	defer ticker.Stop()
+
+	pending := make([]vss.Signal, 0, w.cfg.BatchSize)
+	flush := func() error {
+		if len(pending) == 0 {
+			return nil
+		}
+		err := w.send(pending)
+		pending = make([]vss.Signal, 0, w.cfg.BatchSize)
+		ticker.Reset(w.cfg.FlushInterval)
+		return err
+	}
+	for {
+		select {
+		case sig := <-w.queue:
+			pending = append(pending, sig)
+			if len(pending) >= w.cfg.BatchSize {
+				_ = flush()
+			}
+		case <-ticker.C:
+			_ = flush()
+		case errCh := <-w.flushReq:
+			w.drain(&pending, flush)
+			errCh <- flush()
+		case <-w.done:
+			w.drain(&pending, flush)
+			_ = flush()
+			return
+		}
+	}
+}
+
+// drain moves the queued signals to pending without blocking.
+func (w *Writer) drain(pending *[]vss.Signal, flush func() error) {
+	for {
+		select {
+		case sig := <-w.queue:
+			*pending = append(*pending, sig)
+			if len(*pending) >= w.cfg.BatchSize {
+				_ = flush()
+			}
+		default:
+			return
+		}
+	}
+}
+
+// send inserts the signals, retrying with exponential backoff.
+// If every attempt fails the batch is passed to OnError and the last error is returned.
+func (w *Writer) send(signals []vss.Signal) error {
+	signals = Dedup(signals)
+	backoff := w.cfg.RetryBackoff
+	var err error
+	for attempt := 0; attempt <= w.cfg.MaxRetries; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-timer.C:
+			case <-w.ctx.Done():
+				timer.Stop()
+				err = fmt.Errorf("retry canceled: %w", err)
+				return w.dropped(err, signals)
+			}
+			backoff = min(backoff*2, w.cfg.MaxRetryBackoff)
+		}
+		err = w.insert(signals)
+		if err == nil {
+			return nil
+		}
+	}
+	return w.dropped(fmt.Errorf("failed to insert %d signals after %d attempts: %w", len(signals), w.cfg.MaxRetries+1, err), signals)
+}
+
+func (w *Writer) dropped(err error, signals []vss.Signal) error {
+	if w.cfg.OnError != nil {
+		w.cfg.OnError(err, signals)
+	}
+	return err
+}
+
+// insert sends the signals in a single batch.
+func (w *Writer) insert(signals []vss.Signal) error {
+	batch, err := w.conn.PrepareBatch(w.ctx, w.query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare batch: %w", err)
+	}
+	for i := range signals {
+		if err := batch.Append(vss.SignalToSlice(signals[i])...); err != nil {
+			_ = batch.Abort()
+			return fmt.Errorf("failed to append signal: %w", err)
+		}
+	}
+	if err := batch.Send(); err != nil {
+		return fmt.Errorf("failed to send batch: %w", err)
+	}
+	return nil
+}
+
+// dedupKey matches the ORDER BY of the signal table, timestamps are compared at the microsecond precision of the column.
+type dedupKey struct {
+	tokenID   uint32
+	timestamp int64
+	name      string
+}
+
+// Dedup removes signals with the same token ID, timestamp and name, keeping the last one.
+// The signal table is a ReplacingMergeTree ordered by these columns so only one of them would survive a merge.
+// The order of the remaining signals is kept.
+func Dedup(signals []vss.Signal) []vss.Signal {
+	index := make(map[dedupKey]int, len(signals))
+	deduped := make([]vss.Signal, 0, len(signals))
+	for i := range signals {
+		key := dedupKey{tokenID: signals[i].TokenID, timestamp: signals[i].Timestamp.UnixMicro(), name: signals[i].Name}
+		if j, ok := index[key]; ok {
+			deduped[j] = signals[i]
+			continue
+		}
+		index[key] = len(deduped)
+		deduped = append(deduped, signals[i])
+	}
+	return deduped
+}
@@ -0,0 +1,173 @@
+package chwriter_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/DIMO-Network/clickhouse-infra/pkg/connect/config"
+	"github.com/DIMO-Network/clickhouse-infra/pkg/container"
+	"github.com/DIMO-Network/model-garage/pkg/migrations"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/model-garage/pkg/vss/chwriter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriter(t *testing.T) {
+	ctx := context.Background()
+	chcontainer, err := container.CreateClickHouseContainer(ctx, config.Settings{})
+	require.NoError(t, err, "Failed to create clickhouse container")
+
+	defer chcontainer.Terminate(ctx)
+
+	db, err := chcontainer.GetClickhouseAsDB()
+	require.NoError(t, err, "Failed to get clickhouse db")
+	err = migrations.RunGoose(ctx, []string{"up", "-v"}, db)
+	require.NoError(t, err, "Failed to run migration")
+
+	conn, err := chcontainer.GetClickHouseAsConn()
+	require.NoError(t, err, "Failed to get clickhouse connection")
+
+	writer := chwriter.New(conn, chwriter.Config{BatchSize: 2, FlushInterval: time.Hour})
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	err = writer.Write(ctx,
+		vss.Signal{TokenID: 1, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 10},
+		vss.Signal{TokenID: 1, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 20},
+		vss.Signal{TokenID: 1, Timestamp: ts, Name: vss.FieldPowertrainType, ValueString: "ELECTRIC"},
+	)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close(ctx))
+
+	var count uint64
+	err = conn.QueryRow(ctx, "SELECT count() FROM signal FINAL WHERE token_id = 1").Scan(&count)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+
+	var speed float64
+	err = conn.QueryRow(ctx, "SELECT value_number FROM signal FINAL WHERE token_id = 1 AND name = 'speed'").Scan(&speed)
+	require.NoError(t, err)
+	require.Equal(t, 20.0, speed)
+
+	require.ErrorIs(t, writer.Write(ctx, vss.Signal{}), chwriter.ErrClosed)
+}
+
+// fakeConn records sent batches and fails the first failures sends.
+type fakeConn struct {
+	mu       sync.Mutex
+	failures int
+	attempts int
+	sent     [][]vss.Signal
+}
+
+func (c *fakeConn) PrepareBatch(context.Context, string, ...driver.PrepareBatchOption) (driver.Batch, error) {
+	return &fakeBatch{conn: c}, nil
+}
+
+type fakeBatch struct {
+	driver.Batch
+	conn    *fakeConn
+	signals []vss.Signal
+}
+
+func (b *fakeBatch) Append(v ...any) error {
+	sig := vss.Signal{
+		TokenID:     v[0].(uint32),
+		Timestamp:   v[1].(time.Time),
+		Name:        v[2].(string),
+		ValueNumber: v[4].(float64),
+	}
+	b.signals = append(b.signals, sig)
+	return nil
+}
+
+func (b *fakeBatch) Abort() error { return nil }
+
+func (b *fakeBatch) Send() error {
+	b.conn.mu.Lock()
+	defer b.conn.mu.Unlock()
+	b.conn.attempts++
+	if b.conn.failures > 0 {
+		b.conn.failures--
+		return errors.New("connection reset")
+	}
+	b.conn.sent = append(b.conn.sent, b.signals)
+	return nil
+}
+
+func TestWriterRetry(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := &fakeConn{failures: 2}
+	writer := chwriter.New(conn, chwriter.Config{FlushInterval: time.Hour, RetryBackoff: time.Millisecond})
+	require.NoError(t, writer.Write(ctx, vss.Signal{TokenID: 1, Name: vss.FieldSpeed, ValueNumber: 1}))
+	require.NoError(t, writer.Flush(ctx))
+	require.NoError(t, writer.Close(ctx))
+	require.Equal(t, 3, conn.attempts)
+	require.Len(t, conn.sent, 1)
+}
+
+func TestWriterDropsAfterRetries(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := &fakeConn{failures: 10}
+	var dropped []vss.Signal
+	writer := chwriter.New(conn, chwriter.Config{
+		FlushInterval: time.Hour,
+		MaxRetries:    1,
+		RetryBackoff:  time.Millisecond,
+		OnError:       func(_ error, signals []vss.Signal) { dropped = signals },
+	})
+	require.NoError(t, writer.Write(ctx, vss.Signal{TokenID: 1, Name: vss.FieldSpeed, ValueNumber: 1}))
+	require.Error(t, writer.Flush(ctx))
+	require.NoError(t, writer.Close(ctx))
+	require.Equal(t, 2, conn.attempts)
+	require.Len(t, dropped, 1)
+}
+
+func TestWriterFlushesOnBatchSize(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn := &fakeConn{}
+	writer := chwriter.New(conn, chwriter.Config{BatchSize: 2, FlushInterval: time.Hour})
+	for i := range 5 {
+		require.NoError(t, writer.Write(ctx, vss.Signal{TokenID: uint32(i), Name: vss.FieldSpeed}))
+	}
+	require.NoError(t, writer.Close(ctx))
+	require.Len(t, conn.sent, 3)
+	require.Len(t, conn.sent[0], 2)
+	require.Len(t, conn.sent[2], 1)
+}
+
+func TestWriterBackpressure(t *testing.T) {
+	t.Parallel()
+	conn := &fakeConn{failures: 1000}
+	writer := chwriter.New(conn, chwriter.Config{BatchSize: 1, QueueSize: 1, FlushInterval: time.Hour, RetryBackoff: time.Hour})
+
+	// the first signal is being retried and the second fills the queue, so the third blocks.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := writer.Write(ctx, vss.Signal{Name: "a"}, vss.Signal{Name: "b"}, vss.Signal{Name: "c"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	closeCtx, closeCancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer closeCancel()
+	require.ErrorIs(t, writer.Close(closeCtx), context.DeadlineExceeded)
+}
+
+func TestDedup(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	signals := []vss.Signal{
+		{TokenID: 1, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 1},
+		{TokenID: 1, Timestamp: ts, Name: vss.FieldPowertrainType, ValueString: "ELECTRIC"},
+		{TokenID: 2, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 2},
+		{TokenID: 1, Timestamp: ts.Add(time.Microsecond), Name: vss.FieldSpeed, ValueNumber: 3},
+		// same microsecond as the first signal.
+		{TokenID: 1, Timestamp: ts.Add(time.Nanosecond), Name: vss.FieldSpeed, ValueNumber: 4},
+	}
+	expected := []vss.Signal{signals[4], signals[1], signals[2], signals[3]}
+	require.Equal(t, expected, chwriter.Dedup(signals))
+}
@@ -0,0 +1,21 @@
+package vss
+
+import "time"
+
+// Event represents a discrete vehicle occurrence, such as a harsh braking, reported by a source.
+type Event struct {
+	// Subject is the DID of the entity the event is about.
+	Subject string `json:"subject"`
+	// Source is the entity that produced the event.
+	Source string `json:"source"`
+	// CloudEventID is the ID of the CloudEvent the event was decoded from.
+	CloudEventID string `json:"cloudEventId"`
+	// Name is the name of the event.
+	Name string `json:"name"`
+	// Timestamp is when the event occurred.
+	Timestamp time.Time `json:"timestamp"`
+	// DurationNs is how long the event lasted in nanoseconds.
+	DurationNs uint64 `json:"durationNs"`
+	// Metadata is optional source specific JSON describing the event.
+	Metadata string `json:"metadata,omitempty"`
+}
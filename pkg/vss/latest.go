@@ -0,0 +1,64 @@
+package vss
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+)
+
+// LatestTableName is the name of the table holding the latest value of each signal of a token.
+const LatestTableName = "signal_latest"
+
+// latestQuery merges the aggregate states of signal_latest into the latest signals of a token.
+// The result columns are aliased so that they do not shadow the aggregate columns.
+const latestQuery = "SELECT " +
+	TokenIDCol + ", " +
+	NameCol + ", " +
+	"max(" + TimestampCol + ") AS latest_timestamp, " +
+	"argMaxMerge(" + SourceCol + ") AS latest_source, " +
+	"argMaxMerge(" + ValueNumberCol + ") AS latest_value_number, " +
+	"argMaxMerge(" + ValueStringCol + ") AS latest_value_string, " +
+	"argMaxMerge(" + ValueBoolCol + ") AS latest_value_bool, " +
+	"argMaxMerge(" + ValueIntCol + ") AS latest_value_int, " +
+	"argMaxMerge(" + ValueStringArrayCol + ") AS latest_value_string_array " +
+	"FROM " + LatestTableName + " WHERE " + TokenIDCol + " = ? GROUP BY " + TokenIDCol + ", " + NameCol
+
+// Querier runs a query against Clickhouse, it is implemented by clickhouse.Conn.
+type Querier interface {
+	Query(ctx context.Context, query string, args ...any) (driver.Rows, error)
+}
+
+// GetLatestSignals returns the latest value of each signal of a token keyed by signal name.
+func GetLatestSignals(ctx context.Context, conn Querier, tokenID uint32) (map[string]Signal, error) {
+	rows, err := conn.Query(ctx, latestQuery, tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query latest signals: %w", err)
+	}
+	//nolint:errcheck // the error of rows.Err is returned instead
+	defer rows.Close()
+
+	signals := map[string]Signal{}
+	for rows.Next() {
+		var sig Signal
+		err := rows.Scan(
+			&sig.TokenID,
+			&sig.Name,
+			&sig.Timestamp,
+			&sig.Source,
+			&sig.ValueNumber,
+			&sig.ValueString,
+			&sig.ValueBool,
+			&sig.ValueInt,
+			&sig.ValueStringArray,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan latest signal: %w", err)
+		}
+		signals[sig.Name] = sig
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read latest signals: %w", err)
+	}
+	return signals, nil
+}
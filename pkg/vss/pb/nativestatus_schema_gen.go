@@ -0,0 +1,62 @@
+// Code generated by github.com/DIMO-Network/model-garage DO NOT EDIT.
+package pb
+
+// NativeStatusSchema maps signal names to the fields of the NativeStatusSnapshot message in nativestatus.proto.
+var NativeStatusSchema = NewSchema(map[string]FieldInfo{
+	"angularVelocityYaw":                               {Number: 16, DataType: "float"},
+	"chassisAxleRow1WheelLeftSpeed":                    {Number: 17, DataType: "float"},
+	"chassisAxleRow1WheelLeftTirePressure":             {Number: 18, DataType: "uint16"},
+	"chassisAxleRow1WheelRightSpeed":                   {Number: 19, DataType: "float"},
+	"chassisAxleRow1WheelRightTirePressure":            {Number: 20, DataType: "uint16"},
+	"chassisAxleRow2WheelLeftTirePressure":             {Number: 21, DataType: "uint16"},
+	"chassisAxleRow2WheelRightTirePressure":            {Number: 22, DataType: "uint16"},
+	"currentLocationAltitude":                          {Number: 23, DataType: "double"},
+	"currentLocationIsRedacted":                        {Number: 24, DataType: "boolean"},
+	"currentLocationLatitude":                          {Number: 25, DataType: "double"},
+	"currentLocationLongitude":                         {Number: 26, DataType: "double"},
+	"dimoAftermarketHDOP":                              {Number: 27, DataType: "float"},
+	"dimoAftermarketNSAT":                              {Number: 28, DataType: "float"},
+	"dimoAftermarketSSID":                              {Number: 29, DataType: "string"},
+	"dimoAftermarketWPAState":                          {Number: 30, DataType: "string"},
+	"exteriorAirTemperature":                           {Number: 31, DataType: "float"},
+	"lowVoltageBatteryCurrentVoltage":                  {Number: 32, DataType: "float"},
+	"obdBarometricPressure":                            {Number: 33, DataType: "float"},
+	"obdCommandedEGR":                                  {Number: 34, DataType: "float"},
+	"obdCommandedEVAP":                                 {Number: 35, DataType: "float"},
+	"obdDistanceSinceDTCClear":                         {Number: 36, DataType: "float"},
+	"obdDistanceWithMIL":                               {Number: 37, DataType: "float"},
+	"obdEngineLoad":                                    {Number: 38, DataType: "float"},
+	"obdFuelPressure":                                  {Number: 39, DataType: "float"},
+	"obdIntakeTemp":                                    {Number: 40, DataType: "float"},
+	"obdLongTermFuelTrim1":                             {Number: 41, DataType: "float"},
+	"obdMAP":                                           {Number: 42, DataType: "float"},
+	"obdO2WRSensor1Voltage":                            {Number: 43, DataType: "float"},
+	"obdO2WRSensor2Voltage":                            {Number: 44, DataType: "float"},
+	"obdRunTime":                                       {Number: 45, DataType: "float"},
+	"obdShortTermFuelTrim1":                            {Number: 46, DataType: "float"},
+	"obdWarmupsSinceDTCClear":                          {Number: 47, DataType: "uint8"},
+	"powertrainCombustionEngineECT":                    {Number: 48, DataType: "int16"},
+	"powertrainCombustionEngineEngineOilLevel":         {Number: 49, DataType: "string"},
+	"powertrainCombustionEngineEngineOilRelativeLevel": {Number: 50, DataType: "float"},
+	"powertrainCombustionEngineMAF":                    {Number: 51, DataType: "uint16"},
+	"powertrainCombustionEngineSpeed":                  {Number: 52, DataType: "uint16"},
+	"powertrainCombustionEngineTPS":                    {Number: 53, DataType: "uint8"},
+	"powertrainCombustionEngineTorque":                 {Number: 54, DataType: "uint16"},
+	"powertrainFuelSystemAbsoluteLevel":                {Number: 55, DataType: "float"},
+	"powertrainFuelSystemRelativeLevel":                {Number: 56, DataType: "uint8"},
+	"powertrainFuelSystemSupportedFuelTypes":           {Number: 57, DataType: "string[]"},
+	"powertrainRange":                                  {Number: 58, DataType: "uint32"},
+	"powertrainTractionBatteryChargingChargeLimit":     {Number: 59, DataType: "uint8"},
+	"powertrainTractionBatteryChargingIsCharging":      {Number: 60, DataType: "boolean"},
+	"powertrainTractionBatteryCurrentPower":            {Number: 61, DataType: "float"},
+	"powertrainTractionBatteryCurrentVoltage":          {Number: 62, DataType: "float"},
+	"powertrainTractionBatteryGrossCapacity":           {Number: 63, DataType: "uint16"},
+	"powertrainTractionBatteryStateOfChargeCurrent":    {Number: 64, DataType: "float"},
+	"powertrainTractionBatteryTemperatureAverage":      {Number: 65, DataType: "float"},
+	"powertrainTransmissionCurrentGear":                {Number: 66, DataType: "int8"},
+	"powertrainTransmissionTemperature":                {Number: 67, DataType: "int16"},
+	"powertrainTransmissionTravelledDistance":          {Number: 68, DataType: "float"},
+	"powertrainType":                                   {Number: 69, DataType: "string"},
+	"serviceDistanceToService":                         {Number: 70, DataType: "float"},
+	"speed":                                            {Number: 71, DataType: "float"},
+})
@@ -0,0 +1,364 @@
+// Package pb encodes and decodes batches of vss.Signal with protobuf.
+// Each source has a generated .proto file with a snapshot message that holds the signals sharing a timestamp as typed optional fields,
+// and a generated Schema that maps signal names to the field numbers of that message.
+package pb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// SnapshotsField is the field number of the repeated snapshot field in a batch message.
+	SnapshotsField protowire.Number = 1
+	// TimestampField is the field number of the Unix nanosecond timestamp in a snapshot message.
+	TimestampField protowire.Number = 1
+	// TokenIDField is the field number of the token ID in a snapshot message.
+	TokenIDField protowire.Number = 2
+	// SourceField is the field number of the source in a snapshot message.
+	SourceField protowire.Number = 3
+	// FirstSignalField is the lowest field number used for signals in a snapshot message.
+	FirstSignalField protowire.Number = 16
+)
+
+// FieldInfo is the protobuf field of a signal.
+type FieldInfo struct {
+	// Number is the field number in the snapshot message.
+	Number protowire.Number
+	// DataType is the VSS data type of the signal, such as "float", "uint8" or "string[]".
+	DataType string
+}
+
+// UnknownSignalError is returned when encoding a signal that is not in the schema.
+type UnknownSignalError struct {
+	Name string
+}
+
+// Error returns the error message.
+func (e UnknownSignalError) Error() string {
+	return fmt.Sprintf("signal '%s' is not in the protobuf schema", e.Name)
+}
+
+// InvalidValueError is returned when encoding a signal whose value is outside the range of the integer type of its field,
+// such as a negative value for an unsigned field.
+type InvalidValueError struct {
+	Name     string
+	DataType string
+	Value    float64
+}
+
+// Error returns the error message.
+func (e InvalidValueError) Error() string {
+	return fmt.Sprintf("signal '%s' value %v is not a valid %s", e.Name, e.Value, e.DataType)
+}
+
+// Schema maps signal names to the fields of a snapshot message.
+type Schema struct {
+	fields   map[string]FieldInfo
+	byNumber map[protowire.Number]namedField
+}
+
+type namedField struct {
+	name string
+	FieldInfo
+}
+
+// NewSchema creates a Schema from a map of signal names to fields.
+func NewSchema(fields map[string]FieldInfo) *Schema {
+	schema := &Schema{
+		fields:   fields,
+		byNumber: make(map[protowire.Number]namedField, len(fields)),
+	}
+	for name, field := range fields {
+		schema.byNumber[field.Number] = namedField{name: name, FieldInfo: field}
+	}
+	return schema
+}
+
+// Field returns the field of the named signal.
+func (s *Schema) Field(name string) (FieldInfo, bool) {
+	field, ok := s.fields[name]
+	return field, ok
+}
+
+// snapshot is the encoded signal fields of a single snapshot message.
+type snapshot struct {
+	tokenID   uint32
+	timestamp time.Time
+	source    string
+	fields    []byte
+	names     map[string]struct{}
+}
+
+// Marshal encodes the signals as a batch message.
+// Signals with the same token ID, timestamp and source are written to the same snapshot.
+// Integer and boolean signals are encoded from ValueInt and ValueBool, and fractional ValueNumbers of integer signals are rounded to the nearest integer.
+// Signals that are not in the schema, or whose value is outside the range of their integer type, are skipped
+// and returned as a joined error of UnknownSignalError and InvalidValueError, while the remaining signals are still encoded.
+func (s *Schema) Marshal(signals []vss.Signal) ([]byte, error) {
+	var snapshots []*snapshot
+	var errs error
+	latest := map[string]*snapshot{}
+	for i := range signals {
+		sig := &signals[i]
+		field, ok := s.fields[sig.Name]
+		if !ok {
+			errs = errors.Join(errs, UnknownSignalError{Name: sig.Name})
+			continue
+		}
+		encoded, err := appendSignal(nil, field, sig)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		key := fmt.Sprintf("%d|%d|%s", sig.TokenID, sig.Timestamp.UnixNano(), sig.Source)
+		snap := latest[key]
+		if snap == nil || hasName(snap, sig.Name) {
+			snap = &snapshot{
+				tokenID:   sig.TokenID,
+				timestamp: sig.Timestamp,
+				source:    sig.Source,
+				names:     map[string]struct{}{},
+			}
+			latest[key] = snap
+			snapshots = append(snapshots, snap)
+		}
+		snap.names[sig.Name] = struct{}{}
+		snap.fields = append(snap.fields, encoded...)
+	}
+
+	var batch []byte
+	for _, snap := range snapshots {
+		batch = protowire.AppendTag(batch, SnapshotsField, protowire.BytesType)
+		batch = protowire.AppendBytes(batch, marshalSnapshot(snap))
+	}
+	return batch, errs
+}
+
+func hasName(snap *snapshot, name string) bool {
+	_, ok := snap.names[name]
+	return ok
+}
+
+func marshalSnapshot(snap *snapshot) []byte {
+	var msg []byte
+	msg = protowire.AppendTag(msg, TimestampField, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, uint64(snap.timestamp.UnixNano()))
+	if snap.tokenID != 0 {
+		msg = protowire.AppendTag(msg, TokenIDField, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(snap.tokenID))
+	}
+	if snap.source != "" {
+		msg = protowire.AppendTag(msg, SourceField, protowire.BytesType)
+		msg = protowire.AppendString(msg, snap.source)
+	}
+	return append(msg, snap.fields...)
+}
+
+// appendSignal appends the value of a signal using the wire type of its VSS data type.
+func appendSignal(msg []byte, field FieldInfo, sig *vss.Signal) ([]byte, error) {
+	switch field.DataType {
+	case "string[]":
+		for _, val := range sig.ValueStringArray {
+			msg = protowire.AppendTag(msg, field.Number, protowire.BytesType)
+			msg = protowire.AppendString(msg, val)
+		}
+	case "string":
+		msg = protowire.AppendTag(msg, field.Number, protowire.BytesType)
+		msg = protowire.AppendString(msg, sig.ValueString)
+	case "boolean":
+		msg = protowire.AppendTag(msg, field.Number, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, protowire.EncodeBool(sig.ValueBool || sig.ValueNumber != 0))
+	case "int8", "int16", "int32", "int64":
+		val, err := intValue(sig, field.DataType)
+		if err != nil {
+			return nil, err
+		}
+		msg = protowire.AppendTag(msg, field.Number, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, protowire.EncodeZigZag(val))
+	case "uint8", "uint16", "uint32", "uint64":
+		val, err := intValue(sig, field.DataType)
+		if err != nil {
+			return nil, err
+		}
+		msg = protowire.AppendTag(msg, field.Number, protowire.VarintType)
+		msg = protowire.AppendVarint(msg, uint64(val))
+	case "float", "double":
+		msg = protowire.AppendTag(msg, field.Number, protowire.Fixed64Type)
+		msg = protowire.AppendFixed64(msg, math.Float64bits(sig.ValueNumber))
+	default:
+		return nil, fmt.Errorf("signal '%s' has unsupported data type '%s'", sig.Name, field.DataType)
+	}
+	return msg, nil
+}
+
+// intValue returns the integer value of a signal for the data type of its field.
+// ValueInt is used when it holds the value, otherwise ValueNumber is rounded to the nearest integer and must be within the range of the data type.
+func intValue(sig *vss.Signal, dataType string) (int64, error) {
+	num := sig.ValueNumber
+	if sig.ValueInt != 0 && (num == 0 || num == float64(sig.ValueInt)) {
+		num = float64(sig.ValueInt)
+		// float64 cannot hold every int64 so the 64 bit types only check the sign.
+		_, ok := vss.IntValue(dataType, num)
+		if ok || dataType == "int64" || (dataType == "uint64" && sig.ValueInt > 0) {
+			return sig.ValueInt, nil
+		}
+	}
+	val, ok := vss.IntValue(dataType, math.Round(num))
+	if !ok {
+		return 0, InvalidValueError{Name: sig.Name, DataType: dataType, Value: num}
+	}
+	return val, nil
+}
+
+// Unmarshal decodes a batch message into signals.
+// Fields that are not in the schema are skipped so that data written with a newer schema can still be read.
+func (s *Schema) Unmarshal(data []byte) ([]vss.Signal, error) {
+	var signals []vss.Signal
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, fmt.Errorf("failed to read batch: %w", protowire.ParseError(n))
+		}
+		data = data[n:]
+		if num != SnapshotsField || typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, data)
+			if n < 0 {
+				return nil, fmt.Errorf("failed to read batch: %w", protowire.ParseError(n))
+			}
+			data = data[n:]
+			continue
+		}
+		msg, n := protowire.ConsumeBytes(data)
+		if n < 0 {
+			return nil, fmt.Errorf("failed to read snapshot: %w", protowire.ParseError(n))
+		}
+		data = data[n:]
+		snapSignals, err := s.unmarshalSnapshot(msg)
+		if err != nil {
+			return nil, err
+		}
+		signals = append(signals, snapSignals...)
+	}
+	return signals, nil
+}
+
+func (s *Schema) unmarshalSnapshot(msg []byte) ([]vss.Signal, error) {
+	var base vss.Signal
+	var signals []vss.Signal
+	arrayIndex := map[protowire.Number]int{}
+	for len(msg) > 0 {
+		num, typ, n := protowire.ConsumeTag(msg)
+		if n < 0 {
+			return nil, fmt.Errorf("failed to read snapshot: %w", protowire.ParseError(n))
+		}
+		msg = msg[n:]
+		var val any
+		switch typ {
+		case protowire.VarintType:
+			var v uint64
+			v, n = protowire.ConsumeVarint(msg)
+			val = v
+		case protowire.Fixed64Type:
+			var v uint64
+			v, n = protowire.ConsumeFixed64(msg)
+			val = math.Float64frombits(v)
+		case protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(msg)
+			val = string(v)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, msg)
+		}
+		if n < 0 {
+			return nil, fmt.Errorf("failed to read field %d: %w", num, protowire.ParseError(n))
+		}
+		msg = msg[n:]
+
+		switch num {
+		case TimestampField:
+			if v, ok := val.(uint64); ok {
+				base.Timestamp = time.Unix(0, int64(v)).UTC()
+			}
+			continue
+		case TokenIDField:
+			if v, ok := val.(uint64); ok {
+				base.TokenID = uint32(v)
+			}
+			continue
+		case SourceField:
+			if v, ok := val.(string); ok {
+				base.Source = v
+			}
+			continue
+		}
+		field, ok := s.byNumber[num]
+		if !ok || val == nil {
+			continue
+		}
+		if field.DataType == "string[]" {
+			str, _ := val.(string)
+			if i, ok := arrayIndex[num]; ok {
+				signals[i].ValueStringArray = append(signals[i].ValueStringArray, str)
+				continue
+			}
+			arrayIndex[num] = len(signals)
+			signals = append(signals, vss.Signal{Name: field.name, ValueStringArray: []string{str}})
+			continue
+		}
+		value, err := decodeValue(field, val)
+		if err != nil {
+			return nil, err
+		}
+		sig := vss.Signal{Name: field.name}
+		if err := sig.SetTypedValue(field.DataType, value); err != nil {
+			return nil, fmt.Errorf("failed to set value of field %d: %w", num, err)
+		}
+		signals = append(signals, sig)
+	}
+	for i := range signals {
+		signals[i].TokenID = base.TokenID
+		signals[i].Timestamp = base.Timestamp
+		signals[i].Source = base.Source
+		if signals[i].ValueStringArray != nil {
+			signals[i].SetValue(signals[i].ValueStringArray)
+		}
+	}
+	return signals, nil
+}
+
+var errWireType = errors.New("unexpected wire type")
+
+// decodeValue converts a raw field value to the value passed to vss.Signal.SetTypedValue.
+func decodeValue(field namedField, val any) (any, error) {
+	switch field.DataType {
+	case "string":
+		if str, ok := val.(string); ok {
+			return str, nil
+		}
+	case "float", "double":
+		if num, ok := val.(float64); ok {
+			return num, nil
+		}
+	case "boolean":
+		if num, ok := val.(uint64); ok {
+			return float64(num), nil
+		}
+	default:
+		num, ok := val.(uint64)
+		if !ok {
+			break
+		}
+		if strings.HasPrefix(field.DataType, "int") {
+			return protowire.DecodeZigZag(num), nil
+		}
+		return num, nil
+	}
+	return nil, fmt.Errorf("field %d of signal '%s': %w", field.Number, field.name, errWireType)
+}
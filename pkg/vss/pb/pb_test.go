@@ -0,0 +1,143 @@
+package pb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/model-garage/pkg/vss/pb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 123, time.UTC)
+	base := vss.Signal{TokenID: 7, Timestamp: ts, Source: "0xSource"}
+	newSignal := func(name, dataType string, val any) vss.Signal {
+		sig := base
+		sig.Name = name
+		sig.SetTypedValue(dataType, val)
+		return sig
+	}
+	signals := []vss.Signal{
+		newSignal(vss.FieldSpeed, "float", 12.5),
+		newSignal(vss.FieldOBDWarmupsSinceDTCClear, "uint8", 3.0),
+		newSignal(vss.FieldCurrentLocationIsRedacted, "boolean", true),
+		newSignal(vss.FieldPowertrainType, "string", "ELECTRIC"),
+		newSignal(vss.FieldPowertrainFuelSystemSupportedFuelTypes, "string[]", []string{"GASOLINE", "DIESEL"}),
+		// a second value for the same signal and timestamp starts a new snapshot.
+		newSignal(vss.FieldSpeed, "float", 13.0),
+	}
+
+	data, err := pb.VehicleSchema.Marshal(signals)
+	require.NoError(t, err)
+	decoded, err := pb.VehicleSchema.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, signals, decoded)
+}
+
+func TestSignedIntegers(t *testing.T) {
+	t.Parallel()
+	schema := pb.NewSchema(map[string]pb.FieldInfo{
+		"temperature": {Number: 16, DataType: "int8"},
+	})
+	sig := vss.Signal{Name: "temperature", Timestamp: time.Unix(0, 0).UTC()}
+	sig.SetTypedValue("int8", -40.0)
+
+	data, err := schema.Marshal([]vss.Signal{sig})
+	require.NoError(t, err)
+	decoded, err := schema.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, []vss.Signal{sig}, decoded)
+}
+
+func TestIntegerValues(t *testing.T) {
+	t.Parallel()
+	schema := pb.NewSchema(map[string]pb.FieldInfo{
+		"pressure": {Number: 16, DataType: "uint16"},
+		"distance": {Number: 17, DataType: "int64"},
+	})
+	ts := time.Unix(0, 0).UTC()
+
+	// ValueInt is encoded exactly even when it is beyond the precision of ValueNumber.
+	sig := vss.Signal{Name: "distance", Timestamp: ts}
+	sig.SetValue(int64(1<<53 + 1))
+	data, err := schema.Marshal([]vss.Signal{sig})
+	require.NoError(t, err)
+	decoded, err := schema.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, int64(1<<53+1), decoded[0].ValueInt)
+
+	// fractional values are rounded to the nearest integer.
+	sig = vss.Signal{Name: "pressure", Timestamp: ts}
+	sig.SetTypedValue("uint16", 287.5)
+	data, err = schema.Marshal([]vss.Signal{sig})
+	require.NoError(t, err)
+	decoded, err = schema.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, int64(288), decoded[0].ValueInt)
+
+	tests := []struct {
+		name string
+		val  float64
+	}{
+		{name: "negative unsigned", val: -1},
+		{name: "above range", val: 1 << 16},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sig := vss.Signal{Name: "pressure", Timestamp: ts}
+			sig.SetTypedValue("uint16", tt.val)
+			distance := vss.Signal{Name: "distance", Timestamp: ts}
+			distance.SetValue(int64(12))
+			data, err := schema.Marshal([]vss.Signal{sig, distance})
+			var valueErr pb.InvalidValueError
+			require.ErrorAs(t, err, &valueErr)
+			require.Equal(t, tt.val, valueErr.Value)
+
+			// the valid signal is still encoded.
+			decoded, err := schema.Unmarshal(data)
+			require.NoError(t, err)
+			require.Equal(t, []vss.Signal{distance}, decoded)
+		})
+	}
+}
+
+func TestUnknownSignal(t *testing.T) {
+	t.Parallel()
+	speed := vss.Signal{Name: vss.FieldSpeed, Timestamp: time.Unix(10, 0).UTC(), ValueNumber: 50}
+	data, err := pb.VehicleSchema.Marshal([]vss.Signal{{Name: "notASignal"}, speed})
+	var unknownErr pb.UnknownSignalError
+	require.ErrorAs(t, err, &unknownErr)
+	require.Equal(t, "notASignal", unknownErr.Name)
+
+	decoded, err := pb.VehicleSchema.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, []vss.Signal{speed}, decoded)
+}
+
+func TestUnmarshalSkipsUnknownFields(t *testing.T) {
+	t.Parallel()
+	oldSchema := pb.NewSchema(map[string]pb.FieldInfo{
+		"speed": {Number: 16, DataType: "float"},
+	})
+	newSchema := pb.NewSchema(map[string]pb.FieldInfo{
+		"speed":          {Number: 16, DataType: "float"},
+		"powertrainType": {Number: 17, DataType: "string"},
+	})
+	speed := vss.Signal{Name: "speed", Timestamp: time.Unix(10, 0).UTC(), ValueNumber: 50}
+	powertrainType := vss.Signal{Name: "powertrainType", Timestamp: time.Unix(10, 0).UTC(), ValueString: "ELECTRIC"}
+	data, err := newSchema.Marshal([]vss.Signal{speed, powertrainType})
+	require.NoError(t, err)
+
+	decoded, err := oldSchema.Unmarshal(data)
+	require.NoError(t, err)
+	require.Equal(t, []vss.Signal{speed}, decoded)
+
+	field, ok := newSchema.Field("powertrainType")
+	require.True(t, ok)
+	require.Equal(t, protowire.Number(17), field.Number)
+}
@@ -0,0 +1,33 @@
+// Code generated by github.com/DIMO-Network/model-garage DO NOT EDIT.
+package pb
+
+// RuptelaSchema maps signal names to the fields of the RuptelaSnapshot message in ruptela.proto.
+var RuptelaSchema = NewSchema(map[string]FieldInfo{
+	"chassisAxleRow1WheelLeftTirePressure":                 {Number: 16, DataType: "uint16"},
+	"chassisAxleRow1WheelRightTirePressure":                {Number: 17, DataType: "uint16"},
+	"chassisAxleRow2WheelLeftTirePressure":                 {Number: 18, DataType: "uint16"},
+	"chassisAxleRow2WheelRightTirePressure":                {Number: 19, DataType: "uint16"},
+	"currentLocationAltitude":                              {Number: 20, DataType: "double"},
+	"currentLocationLatitude":                              {Number: 21, DataType: "double"},
+	"currentLocationLongitude":                             {Number: 22, DataType: "double"},
+	"dimoAftermarketHDOP":                                  {Number: 23, DataType: "float"},
+	"dimoAftermarketNSAT":                                  {Number: 24, DataType: "float"},
+	"exteriorAirTemperature":                               {Number: 25, DataType: "float"},
+	"lowVoltageBatteryCurrentVoltage":                      {Number: 26, DataType: "float"},
+	"obdDistanceWithMIL":                                   {Number: 27, DataType: "float"},
+	"obdRunTime":                                           {Number: 28, DataType: "float"},
+	"powertrainCombustionEngineDieselExhaustFluidCapacity": {Number: 29, DataType: "float"},
+	"powertrainCombustionEngineDieselExhaustFluidLevel":    {Number: 30, DataType: "uint8"},
+	"powertrainCombustionEngineECT":                        {Number: 31, DataType: "int16"},
+	"powertrainCombustionEngineEngineOilLevel":             {Number: 32, DataType: "string"},
+	"powertrainCombustionEngineEngineOilRelativeLevel":     {Number: 33, DataType: "float"},
+	"powertrainCombustionEngineSpeed":                      {Number: 34, DataType: "uint16"},
+	"powertrainCombustionEngineTPS":                        {Number: 35, DataType: "uint8"},
+	"powertrainFuelSystemAbsoluteLevel":                    {Number: 36, DataType: "float"},
+	"powertrainFuelSystemRelativeLevel":                    {Number: 37, DataType: "uint8"},
+	"powertrainTractionBatteryRange":                       {Number: 38, DataType: "uint32"},
+	"powertrainTractionBatteryStateOfChargeCurrent":        {Number: 39, DataType: "float"},
+	"powertrainTransmissionTravelledDistance":              {Number: 40, DataType: "float"},
+	"powertrainType":                                       {Number: 41, DataType: "string"},
+	"speed":                                                {Number: 42, DataType: "float"},
+})
@@ -0,0 +1,21 @@
+// Code generated by github.com/DIMO-Network/model-garage DO NOT EDIT.
+package pb
+
+// TeslaSchema maps signal names to the fields of the TeslaSnapshot message in tesla.proto.
+var TeslaSchema = NewSchema(map[string]FieldInfo{
+	"chassisAxleRow1WheelLeftTirePressure":          {Number: 16, DataType: "uint16"},
+	"chassisAxleRow1WheelRightTirePressure":         {Number: 17, DataType: "uint16"},
+	"chassisAxleRow2WheelLeftTirePressure":          {Number: 18, DataType: "uint16"},
+	"chassisAxleRow2WheelRightTirePressure":         {Number: 19, DataType: "uint16"},
+	"currentLocationLatitude":                       {Number: 20, DataType: "double"},
+	"currentLocationLongitude":                      {Number: 21, DataType: "double"},
+	"exteriorAirTemperature":                        {Number: 22, DataType: "float"},
+	"powertrainRange":                               {Number: 23, DataType: "uint32"},
+	"powertrainTractionBatteryChargingAddedEnergy":  {Number: 24, DataType: "float"},
+	"powertrainTractionBatteryChargingChargeLimit":  {Number: 25, DataType: "uint8"},
+	"powertrainTractionBatteryChargingIsCharging":   {Number: 26, DataType: "boolean"},
+	"powertrainTractionBatteryCurrentPower":         {Number: 27, DataType: "float"},
+	"powertrainTractionBatteryStateOfChargeCurrent": {Number: 28, DataType: "float"},
+	"powertrainTransmissionTravelledDistance":       {Number: 29, DataType: "float"},
+	"speed": {Number: 30, DataType: "float"},
+})
@@ -0,0 +1,70 @@
+// Code generated by github.com/DIMO-Network/model-garage DO NOT EDIT.
+package pb
+
+// VehicleSchema maps signal names to the fields of the VehicleSnapshot message in vehicle.proto.
+var VehicleSchema = NewSchema(map[string]FieldInfo{
+	"angularVelocityYaw":                                   {Number: 16, DataType: "float"},
+	"chassisAxleRow1WheelLeftSpeed":                        {Number: 17, DataType: "float"},
+	"chassisAxleRow1WheelLeftTirePressure":                 {Number: 18, DataType: "uint16"},
+	"chassisAxleRow1WheelRightSpeed":                       {Number: 19, DataType: "float"},
+	"chassisAxleRow1WheelRightTirePressure":                {Number: 20, DataType: "uint16"},
+	"chassisAxleRow2WheelLeftTirePressure":                 {Number: 21, DataType: "uint16"},
+	"chassisAxleRow2WheelRightTirePressure":                {Number: 22, DataType: "uint16"},
+	"currentLocationAltitude":                              {Number: 23, DataType: "double"},
+	"currentLocationIsRedacted":                            {Number: 24, DataType: "boolean"},
+	"currentLocationLatitude":                              {Number: 25, DataType: "double"},
+	"currentLocationLongitude":                             {Number: 26, DataType: "double"},
+	"dimoAftermarketHDOP":                                  {Number: 27, DataType: "float"},
+	"dimoAftermarketNSAT":                                  {Number: 28, DataType: "float"},
+	"dimoAftermarketSSID":                                  {Number: 29, DataType: "string"},
+	"dimoAftermarketWPAState":                              {Number: 30, DataType: "string"},
+	"exteriorAirTemperature":                               {Number: 31, DataType: "float"},
+	"lowVoltageBatteryCurrentVoltage":                      {Number: 32, DataType: "float"},
+	"obdBarometricPressure":                                {Number: 33, DataType: "float"},
+	"obdCommandedEGR":                                      {Number: 34, DataType: "float"},
+	"obdCommandedEVAP":                                     {Number: 35, DataType: "float"},
+	"obdDistanceSinceDTCClear":                             {Number: 36, DataType: "float"},
+	"obdDistanceWithMIL":                                   {Number: 37, DataType: "float"},
+	"obdEngineLoad":                                        {Number: 38, DataType: "float"},
+	"obdFuelPressure":                                      {Number: 39, DataType: "float"},
+	"obdIntakeTemp":                                        {Number: 40, DataType: "float"},
+	"obdLongTermFuelTrim1":                                 {Number: 41, DataType: "float"},
+	"obdMAP":                                               {Number: 42, DataType: "float"},
+	"obdO2WRSensor1Voltage":                                {Number: 43, DataType: "float"},
+	"obdO2WRSensor2Voltage":                                {Number: 44, DataType: "float"},
+	"obdRunTime":                                           {Number: 45, DataType: "float"},
+	"obdShortTermFuelTrim1":                                {Number: 46, DataType: "float"},
+	"obdWarmupsSinceDTCClear":                              {Number: 47, DataType: "uint8"},
+	"powertrainCombustionEngineDieselExhaustFluidCapacity": {Number: 48, DataType: "float"},
+	"powertrainCombustionEngineDieselExhaustFluidLevel":    {Number: 49, DataType: "uint8"},
+	"powertrainCombustionEngineECT":                        {Number: 50, DataType: "int16"},
+	"powertrainCombustionEngineEngineOilLevel":             {Number: 51, DataType: "string"},
+	"powertrainCombustionEngineEngineOilRelativeLevel":     {Number: 52, DataType: "float"},
+	"powertrainCombustionEngineMAF":                        {Number: 53, DataType: "uint16"},
+	"powertrainCombustionEngineSpeed":                      {Number: 54, DataType: "uint16"},
+	"powertrainCombustionEngineTPS":                        {Number: 55, DataType: "uint8"},
+	"powertrainCombustionEngineTorque":                     {Number: 56, DataType: "uint16"},
+	"powertrainFuelSystemAbsoluteLevel":                    {Number: 57, DataType: "float"},
+	"powertrainFuelSystemRelativeLevel":                    {Number: 58, DataType: "uint8"},
+	"powertrainFuelSystemSupportedFuelTypes":               {Number: 59, DataType: "string[]"},
+	"powertrainRange":                                      {Number: 60, DataType: "uint32"},
+	"powertrainTractionBatteryChargingAddedEnergy":         {Number: 61, DataType: "float"},
+	"powertrainTractionBatteryChargingChargeLimit":         {Number: 62, DataType: "uint8"},
+	"powertrainTractionBatteryChargingIsCharging":          {Number: 63, DataType: "boolean"},
+	"powertrainTractionBatteryCurrentPower":                {Number: 64, DataType: "float"},
+	"powertrainTractionBatteryCurrentVoltage":              {Number: 65, DataType: "float"},
+	"powertrainTractionBatteryGrossCapacity":               {Number: 66, DataType: "uint16"},
+	"powertrainTractionBatteryRange":                       {Number: 67, DataType: "uint32"},
+	"powertrainTractionBatteryStateOfChargeCurrent":        {Number: 68, DataType: "float"},
+	"powertrainTractionBatteryTemperatureAverage":          {Number: 69, DataType: "float"},
+	"powertrainTransmissionCurrentGear":                    {Number: 70, DataType: "int8"},
+	"powertrainTransmissionTemperature":                    {Number: 71, DataType: "int16"},
+	"powertrainTransmissionTravelledDistance":              {Number: 72, DataType: "float"},
+	"powertrainType":                                       {Number: 73, DataType: "string"},
+	"serviceDistanceToService":                             {Number: 74, DataType: "float"},
+	"speed":                                                {Number: 75, DataType: "float"},
+	"dimoAftermarketGSMSignalLevel":                        {Number: 76, DataType: "uint8"},
+	"dimoAftermarketModemTemperature":                      {Number: 77, DataType: "float"},
+	"dimoAftermarketPCBTemperature":                        {Number: 78, DataType: "float"},
+	"dimoAftermarketSupplyVoltage":                         {Number: 79, DataType: "float"},
+})
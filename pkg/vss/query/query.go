@@ -0,0 +1,326 @@
+// Package query builds parameterized ClickHouse queries against the signal table.
+package query
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+)
+
+// AggFunc is an aggregation applied to the values of a signal in a time bucket.
+type AggFunc string
+
+const (
+	// Avg is the average value in the bucket.
+	Avg AggFunc = "avg"
+	// Min is the smallest value in the bucket.
+	Min AggFunc = "min"
+	// Max is the largest value in the bucket.
+	Max AggFunc = "max"
+	// First is the value with the earliest timestamp in the bucket.
+	First AggFunc = "first"
+	// Last is the value with the latest timestamp in the bucket.
+	Last AggFunc = "last"
+)
+
+// valueCols are the columns that can be selected, in table order.
+var valueCols = []string{
+	vss.ValueNumberCol,
+	vss.ValueStringCol,
+	vss.ValueBoolCol,
+	vss.ValueIntCol,
+	vss.ValueStringArrayCol,
+}
+
+// numericCols are the columns that avg, min and max can be applied to.
+var numericCols = []string{vss.ValueNumberCol, vss.ValueIntCol}
+
+// Query is a SQL statement and its positional arguments.
+type Query struct {
+	SQL  string
+	Args []any
+}
+
+// Aggregation is an aggregate function applied to a value column.
+type Aggregation struct {
+	Func   AggFunc
+	Column string
+}
+
+// Alias returns the name of the result column of the aggregation, for example avg_value_number.
+func (a Aggregation) Alias() string {
+	return string(a.Func) + "_" + a.Column
+}
+
+// PrivilegeError is returned when a signal is queried without all of its required privileges,
+// or when the privileges of the signal are not known.
+type PrivilegeError struct {
+	Name    string
+	Missing []string
+}
+
+func (e PrivilegeError) Error() string {
+	if len(e.Missing) == 0 {
+		return fmt.Sprintf("signal '%s' has no known privileges", e.Name)
+	}
+	return fmt.Sprintf("signal '%s' requires privileges %v", e.Name, e.Missing)
+}
+
+// ColumnError is returned for a column or aggregation that is not allowed.
+type ColumnError struct {
+	Column string
+	Reason string
+}
+
+func (e ColumnError) Error() string {
+	return fmt.Sprintf("column '%s' %s", e.Column, e.Reason)
+}
+
+// Builder builds queries for the signals of a single token.
+// Builder methods return the Builder so they can be chained.
+type Builder struct {
+	tokenID    uint32
+	names      []string
+	sources    []string
+	from       time.Time
+	to         time.Time
+	granted    []string
+	privileges map[string][]string
+}
+
+// New creates a Builder for the signals of tokenID.
+func New(tokenID uint32) *Builder {
+	return &Builder{tokenID: tokenID}
+}
+
+// Names limits the query to signals with these names.
+func (b *Builder) Names(names ...string) *Builder {
+	b.names = append(b.names, names...)
+	return b
+}
+
+// Sources limits the query to signals from these sources.
+func (b *Builder) Sources(sources ...string) *Builder {
+	b.sources = append(b.sources, sources...)
+	return b
+}
+
+// Between limits the query to signals with from <= timestamp < to.
+// A zero from or to leaves that side open.
+func (b *Builder) Between(from, to time.Time) *Builder {
+	b.from = from
+	b.to = to
+	return b
+}
+
+// Granted limits the query to signals whose required privileges are all in granted.
+// Querying a named signal without its privileges returns a PrivilegeError, and without names only the permitted signals are queried.
+// If the privileges do not cover any signal, the query matches no rows.
+func (b *Builder) Granted(granted ...string) *Builder {
+	if b.granted == nil {
+		b.granted = []string{}
+	}
+	b.granted = append(b.granted, granted...)
+	return b
+}
+
+// WithPrivileges sets the required privileges of each signal name used by Granted.
+// Defaults to DefaultPrivileges.
+func (b *Builder) WithPrivileges(privileges map[string][]string) *Builder {
+	b.privileges = privileges
+	return b
+}
+
+// Latest returns a query for the latest value of each signal.
+// The result columns are name, last_timestamp and last_<column> for each of the given value columns.
+// If no columns are given, all value columns are selected.
+func (b *Builder) Latest(cols ...string) (Query, error) {
+	if len(cols) == 0 {
+		cols = valueCols
+	}
+	selects := []string{vss.NameCol, fmt.Sprintf("max(%s) AS last_%s", vss.TimestampCol, vss.TimestampCol)}
+	for _, col := range cols {
+		if !slices.Contains(valueCols, col) {
+			return Query{}, ColumnError{Column: col, Reason: "is not a value column"}
+		}
+		selects = append(selects, fmt.Sprintf("argMax(%s, %s) AS last_%s", col, vss.TimestampCol, col))
+	}
+	where, args, err := b.where()
+	if err != nil {
+		return Query{}, err
+	}
+	sql := fmt.Sprintf("SELECT %s FROM %s WHERE %s GROUP BY %s ORDER BY %s",
+		strings.Join(selects, ", "), vss.TableName, where, vss.NameCol, vss.NameCol)
+	return Query{SQL: sql, Args: args}, nil
+}
+
+// Buckets returns a query that aggregates the values of each signal into time buckets of the given interval.
+// The result columns are name, bucket and the Alias of each aggregation.
+// The interval must be a whole number of seconds.
+func (b *Builder) Buckets(interval time.Duration, aggs ...Aggregation) (Query, error) {
+	if interval < time.Second || interval%time.Second != 0 {
+		return Query{}, fmt.Errorf("interval '%s' must be a whole number of seconds", interval)
+	}
+	if len(aggs) == 0 {
+		return Query{}, fmt.Errorf("at least one aggregation is required")
+	}
+	selects := []string{vss.NameCol, fmt.Sprintf("toStartOfInterval(%s, toIntervalSecond(?)) AS bucket", vss.TimestampCol)}
+	for _, agg := range aggs {
+		expr, err := aggExpr(agg)
+		if err != nil {
+			return Query{}, err
+		}
+		selects = append(selects, expr+" AS "+agg.Alias())
+	}
+	where, args, err := b.where()
+	if err != nil {
+		return Query{}, err
+	}
+	args = append([]any{int64(interval / time.Second)}, args...)
+	sql := fmt.Sprintf("SELECT %s FROM %s WHERE %s GROUP BY %s, bucket ORDER BY %s, bucket",
+		strings.Join(selects, ", "), vss.TableName, where, vss.NameCol, vss.NameCol)
+	return Query{SQL: sql, Args: args}, nil
+}
+
+// aggExpr returns the SQL expression of an aggregation.
+func aggExpr(agg Aggregation) (string, error) {
+	if !slices.Contains(valueCols, agg.Column) {
+		return "", ColumnError{Column: agg.Column, Reason: "is not a value column"}
+	}
+	switch agg.Func {
+	case Avg, Min, Max:
+		if !slices.Contains(numericCols, agg.Column) {
+			return "", ColumnError{Column: agg.Column, Reason: fmt.Sprintf("is not numeric and cannot be aggregated with %s", agg.Func)}
+		}
+		return fmt.Sprintf("%s(%s)", agg.Func, agg.Column), nil
+	case First:
+		return fmt.Sprintf("argMin(%s, %s)", agg.Column, vss.TimestampCol), nil
+	case Last:
+		return fmt.Sprintf("argMax(%s, %s)", agg.Column, vss.TimestampCol), nil
+	default:
+		return "", ColumnError{Column: agg.Column, Reason: fmt.Sprintf("has unknown aggregation '%s'", agg.Func)}
+	}
+}
+
+// where returns the WHERE clause of the filters and its arguments.
+func (b *Builder) where() (string, []any, error) {
+	names, err := b.permittedNames()
+	if err != nil {
+		return "", nil, err
+	}
+	conds := []string{vss.TokenIDCol + " = ?"}
+	args := []any{b.tokenID}
+	if names != nil {
+		cond, inArgs := in(vss.NameCol, names)
+		conds = append(conds, cond)
+		args = append(args, inArgs...)
+	}
+	if len(b.sources) != 0 {
+		cond, inArgs := in(vss.SourceCol, b.sources)
+		conds = append(conds, cond)
+		args = append(args, inArgs...)
+	}
+	// timestamps are passed as microseconds to keep the precision of the column.
+	if !b.from.IsZero() {
+		conds = append(conds, vss.TimestampCol+" >= fromUnixTimestamp64Micro(?)")
+		args = append(args, b.from.UnixMicro())
+	}
+	if !b.to.IsZero() {
+		conds = append(conds, vss.TimestampCol+" < fromUnixTimestamp64Micro(?)")
+		args = append(args, b.to.UnixMicro())
+	}
+	return strings.Join(conds, " AND "), args, nil
+}
+
+// permittedNames returns the signal names to filter on, or nil for all signals.
+// An empty non-nil slice is returned when the granted privileges do not cover any signal.
+func (b *Builder) permittedNames() ([]string, error) {
+	if b.granted == nil {
+		if len(b.names) == 0 {
+			return nil, nil
+		}
+		return b.names, nil
+	}
+	privileges := b.privileges
+	if privileges == nil {
+		var err error
+		privileges, err = loadDefaultPrivileges()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(b.names) != 0 {
+		for _, name := range b.names {
+			if _, ok := privileges[name]; !ok {
+				return nil, PrivilegeError{Name: name}
+			}
+			if missing := missingPrivileges(privileges[name], b.granted); len(missing) != 0 {
+				return nil, PrivilegeError{Name: name, Missing: missing}
+			}
+		}
+		return b.names, nil
+	}
+	names := []string{}
+	for name, required := range privileges {
+		if len(missingPrivileges(required, b.granted)) == 0 {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func missingPrivileges(required, granted []string) []string {
+	var missing []string
+	for _, priv := range required {
+		if !slices.Contains(granted, priv) {
+			missing = append(missing, priv)
+		}
+	}
+	return missing
+}
+
+// in returns an IN condition with a placeholder for each value.
+// Without values the condition is always false, since ClickHouse does not accept an empty IN list.
+func in(col string, values []string) (string, []any) {
+	if len(values) == 0 {
+		return "0", nil
+	}
+	placeholders := make([]string, len(values))
+	args := make([]any, len(values))
+	for i, val := range values {
+		placeholders[i] = "?"
+		args[i] = val
+	}
+	return fmt.Sprintf("%s IN (%s)", col, strings.Join(placeholders, ", ")), args
+}
+
+var loadDefaultPrivileges = sync.OnceValues(func() (map[string][]string, error) {
+	tmplData, err := schema.GetDefinedSignals(strings.NewReader(schema.VssRel42DIMO()), strings.NewReader(schema.DefaultDefinitionsYAML()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load default definitions: %w", err)
+	}
+	privileges := make(map[string][]string, len(tmplData.Signals))
+	for _, sig := range tmplData.Signals {
+		privileges[sig.JSONName] = sig.Privileges
+	}
+	return privileges, nil
+})
+
+// DefaultPrivileges returns the required privileges of each signal name in the default definitions.
+func DefaultPrivileges() (map[string][]string, error) {
+	privileges, err := loadDefaultPrivileges()
+	if err != nil {
+		return nil, err
+	}
+	cloned := make(map[string][]string, len(privileges))
+	for name, required := range privileges {
+		cloned[name] = slices.Clone(required)
+	}
+	return cloned, nil
+}
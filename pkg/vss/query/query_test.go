@@ -0,0 +1,136 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/clickhouse-infra/pkg/connect/config"
+	"github.com/DIMO-Network/clickhouse-infra/pkg/container"
+	"github.com/DIMO-Network/model-garage/pkg/migrations"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/model-garage/pkg/vss/query"
+	"github.com/stretchr/testify/require"
+)
+
+var testPrivileges = map[string][]string{
+	vss.FieldSpeed:                   {"VEHICLE_NON_LOCATION_DATA"},
+	vss.FieldPowertrainType:          {"VEHICLE_NON_LOCATION_DATA"},
+	vss.FieldCurrentLocationLatitude: {"VEHICLE_ALL_TIME_LOCATION"},
+}
+
+func TestLatest(t *testing.T) {
+	t.Parallel()
+	from := time.UnixMicro(1_000_000)
+	q, err := query.New(7).Names(vss.FieldSpeed).Sources("0xA", "0xB").Between(from, time.Time{}).Latest(vss.ValueNumberCol)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT name, max(timestamp) AS last_timestamp, argMax(value_number, timestamp) AS last_value_number FROM signal "+
+		"WHERE token_id = ? AND name IN (?) AND source IN (?, ?) AND timestamp >= fromUnixTimestamp64Micro(?) GROUP BY name ORDER BY name", q.SQL)
+	require.Equal(t, []any{uint32(7), vss.FieldSpeed, "0xA", "0xB", int64(1_000_000)}, q.Args)
+
+	_, err = query.New(7).Latest("token_id")
+	var colErr query.ColumnError
+	require.ErrorAs(t, err, &colErr)
+}
+
+func TestBuckets(t *testing.T) {
+	t.Parallel()
+	q, err := query.New(7).Names(vss.FieldSpeed).Buckets(time.Hour,
+		query.Aggregation{Func: query.Avg, Column: vss.ValueNumberCol},
+		query.Aggregation{Func: query.Last, Column: vss.ValueStringCol},
+	)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT name, toStartOfInterval(timestamp, toIntervalSecond(?)) AS bucket, avg(value_number) AS avg_value_number, "+
+		"argMax(value_string, timestamp) AS last_value_string FROM signal WHERE token_id = ? AND name IN (?) GROUP BY name, bucket ORDER BY name, bucket", q.SQL)
+	require.Equal(t, []any{int64(3600), uint32(7), vss.FieldSpeed}, q.Args)
+
+	_, err = query.New(7).Buckets(time.Hour, query.Aggregation{Func: query.Max, Column: vss.ValueStringCol})
+	var colErr query.ColumnError
+	require.ErrorAs(t, err, &colErr)
+
+	_, err = query.New(7).Buckets(time.Millisecond, query.Aggregation{Func: query.Max, Column: vss.ValueNumberCol})
+	require.Error(t, err)
+}
+
+func TestGranted(t *testing.T) {
+	t.Parallel()
+	q, err := query.New(7).WithPrivileges(testPrivileges).Granted("VEHICLE_NON_LOCATION_DATA").Latest(vss.ValueNumberCol)
+	require.NoError(t, err)
+	require.Contains(t, q.SQL, "name IN (?, ?)")
+	require.Equal(t, []any{uint32(7), vss.FieldPowertrainType, vss.FieldSpeed}, q.Args)
+
+	_, err = query.New(7).WithPrivileges(testPrivileges).Names(vss.FieldCurrentLocationLatitude).Granted("VEHICLE_NON_LOCATION_DATA").Latest()
+	var privErr query.PrivilegeError
+	require.ErrorAs(t, err, &privErr)
+	require.Equal(t, []string{"VEHICLE_ALL_TIME_LOCATION"}, privErr.Missing)
+
+	q, err = query.New(7).Names(vss.FieldSpeed).Granted("VEHICLE_NON_LOCATION_DATA").Latest()
+	require.NoError(t, err, "default privileges should allow speed")
+	require.Contains(t, q.SQL, "name IN (?)")
+
+	q, err = query.New(7).WithPrivileges(testPrivileges).Granted("VEHICLE_ALL_TIME_LOCATION").Latest(vss.ValueNumberCol)
+	require.NoError(t, err)
+	require.Contains(t, q.SQL, "name IN (?)")
+
+	q, err = query.New(7).WithPrivileges(testPrivileges).Granted("VEHICLE_COMMANDS").Latest(vss.ValueNumberCol)
+	require.NoError(t, err)
+	require.NotContains(t, q.SQL, "IN ()")
+	require.Contains(t, q.SQL, "WHERE token_id = ? AND 0 GROUP BY name")
+	require.Equal(t, []any{uint32(7)}, q.Args)
+}
+
+func TestQueries(t *testing.T) {
+	ctx := context.Background()
+	chcontainer, err := container.CreateClickHouseContainer(ctx, config.Settings{})
+	require.NoError(t, err, "Failed to create clickhouse container")
+
+	defer chcontainer.Terminate(ctx)
+
+	db, err := chcontainer.GetClickhouseAsDB()
+	require.NoError(t, err, "Failed to get clickhouse db")
+	err = migrations.RunGoose(ctx, []string{"up", "-v"}, db)
+	require.NoError(t, err, "Failed to run migration")
+
+	conn, err := chcontainer.GetClickHouseAsConn()
+	require.NoError(t, err, "Failed to get clickhouse connection")
+
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	signals := []vss.Signal{
+		{TokenID: 1, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 10, Source: "0xA"},
+		{TokenID: 1, Timestamp: ts.Add(time.Minute), Name: vss.FieldSpeed, ValueNumber: 30, Source: "0xA"},
+		{TokenID: 1, Timestamp: ts.Add(2 * time.Minute), Name: vss.FieldSpeed, ValueNumber: 50, Source: "0xB"},
+		{TokenID: 2, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 90, Source: "0xA"},
+	}
+	batch, err := conn.PrepareBatch(ctx, "INSERT INTO "+vss.TableName)
+	require.NoError(t, err)
+	for _, sig := range signals {
+		require.NoError(t, batch.Append(vss.SignalToSlice(sig)...))
+	}
+	require.NoError(t, batch.Send())
+
+	q, err := query.New(1).Names(vss.FieldSpeed).Sources("0xA").Latest(vss.ValueNumberCol)
+	require.NoError(t, err)
+	var name string
+	var lastTimestamp time.Time
+	var lastValue float64
+	err = conn.QueryRow(ctx, q.SQL, q.Args...).Scan(&name, &lastTimestamp, &lastValue)
+	require.NoError(t, err)
+	require.Equal(t, vss.FieldSpeed, name)
+	require.Equal(t, ts.Add(time.Minute), lastTimestamp.UTC())
+	require.Equal(t, 30.0, lastValue)
+
+	q, err = query.New(1).Names(vss.FieldSpeed).Between(ts, ts.Add(time.Hour)).Buckets(time.Hour,
+		query.Aggregation{Func: query.Avg, Column: vss.ValueNumberCol},
+		query.Aggregation{Func: query.Min, Column: vss.ValueNumberCol},
+		query.Aggregation{Func: query.Max, Column: vss.ValueNumberCol},
+		query.Aggregation{Func: query.First, Column: vss.ValueNumberCol},
+		query.Aggregation{Func: query.Last, Column: vss.ValueNumberCol},
+	)
+	require.NoError(t, err)
+	var bucket time.Time
+	var avg, minVal, maxVal, first, last float64
+	err = conn.QueryRow(ctx, q.SQL, q.Args...).Scan(&name, &bucket, &avg, &minVal, &maxVal, &first, &last)
+	require.NoError(t, err)
+	require.Equal(t, ts, bucket.UTC())
+	require.Equal(t, []float64{30, 10, 50, 10, 50}, []float64{avg, minVal, maxVal, first, last})
+}
@@ -3,6 +3,9 @@ package vss
 
 import (
 	"fmt"
+	"math"
+	"reflect"
+	"strings"
 	"time"
 )
 
@@ -22,6 +25,12 @@ const (
 	ValueNumberCol = "value_number"
 	// ValueStringCol is the name of the value_string column in Clickhouse.
 	ValueStringCol = "value_string"
+	// ValueBoolCol is the name of the value_bool column in Clickhouse.
+	ValueBoolCol = "value_bool"
+	// ValueIntCol is the name of the value_int column in Clickhouse.
+	ValueIntCol = "value_int"
+	// ValueStringArrayCol is the name of the value_string_array column in Clickhouse.
+	ValueStringArrayCol = "value_string_array"
 )
 
 // Signal represents a single signal collected from a device.
@@ -44,20 +53,129 @@ type Signal struct {
 
 	// Source is the source of the signal collected.
 	Source string `ch:"source" json:"source"`
+
+	// ValueBool is the value of a boolean signal collected.
+	ValueBool bool `ch:"value_bool" json:"valueBool,omitempty"`
+
+	// ValueInt is the value of an integer signal collected.
+	ValueInt int64 `ch:"value_int" json:"valueInt,omitempty"`
+
+	// ValueStringArray is the value of a string array signal collected.
+	ValueStringArray []string `ch:"value_string_array" json:"valueStringArray,omitempty"`
+
+	// Unit is the unit of the value for signals that are not in the VSS schema, such as Ruptela raw OIDs.
+	// VSS signals use the unit of their definition and leave it empty. It is not stored in Clickhouse.
+	Unit string `ch:"-" json:"unit,omitempty"`
 }
 
 // SetValue dynamically set the appropriate value field based on the type of the value.
+// Booleans and integers are also written to ValueNumber, and string arrays to ValueString, so consumers of the original columns keep working.
 func (s *Signal) SetValue(val any) {
 	switch typedVal := val.(type) {
 	case float64:
 		s.ValueNumber = typedVal
 	case string:
 		s.ValueString = typedVal
+	case bool:
+		s.ValueBool = typedVal
+		if typedVal {
+			s.ValueNumber = 1
+		}
+	case int:
+		s.setInt(int64(typedVal))
+	case int8:
+		s.setInt(int64(typedVal))
+	case int16:
+		s.setInt(int64(typedVal))
+	case int32:
+		s.setInt(int64(typedVal))
+	case int64:
+		s.setInt(typedVal)
+	case uint8:
+		s.setInt(int64(typedVal))
+	case uint16:
+		s.setInt(int64(typedVal))
+	case uint32:
+		s.setInt(int64(typedVal))
+	case uint:
+		s.setUint(uint64(typedVal))
+	case uint64:
+		s.setUint(typedVal)
+	case []string:
+		s.ValueStringArray = typedVal
+		s.ValueString = fmt.Sprintf("%v", val)
 	default:
 		s.ValueString = fmt.Sprintf("%v", val)
 	}
 }
 
+// SetTypedValue sets the value fields of the signal using the VSS data type of the signal, such as "boolean", "uint8" or "string[]".
+// Generated conversion functions return float64 for every numeric VSS type,
+// so the data type decides whether the value is also stored as a boolean or an integer.
+// An error is returned if an array data type is given a value that is not a slice.
+func (s *Signal) SetTypedValue(dataType string, val any) error {
+	if strings.HasSuffix(dataType, "[]") {
+		if reflect.ValueOf(val).Kind() != reflect.Slice {
+			return fmt.Errorf("value of type %T is not valid for data type '%s'", val, dataType)
+		}
+	}
+	s.SetValue(val)
+	num, ok := val.(float64)
+	if !ok {
+		return nil
+	}
+	switch dataType {
+	case "boolean":
+		s.ValueBool = num != 0
+	default:
+		if val, ok := IntValue(dataType, num); ok {
+			s.ValueInt = val
+		}
+	}
+	return nil
+}
+
+// intRanges are the ranges of the integer VSS data types that fit in ValueInt.
+var intRanges = map[string][2]float64{
+	"int8":   {math.MinInt8, math.MaxInt8},
+	"int16":  {math.MinInt16, math.MaxInt16},
+	"int32":  {math.MinInt32, math.MaxInt32},
+	"int64":  {math.MinInt64, math.MaxInt64},
+	"uint8":  {0, math.MaxUint8},
+	"uint16": {0, math.MaxUint16},
+	"uint32": {0, math.MaxUint32},
+	"uint64": {0, math.MaxInt64},
+}
+
+// IntValue returns num as an integer of the given VSS data type.
+// ok is false if the data type is not an integer type, or num is fractional or outside the range of the data type,
+// in which case the value is only kept in ValueNumber.
+func IntValue(dataType string, num float64) (val int64, ok bool) {
+	bounds, isInt := intRanges[dataType]
+	if !isInt || num != math.Trunc(num) || num < bounds[0] || num > bounds[1] {
+		return 0, false
+	}
+	// float64(math.MaxInt64) rounds up to 2^63 which does not fit in an int64.
+	if num >= math.MaxInt64 {
+		return 0, false
+	}
+	return int64(num), true
+}
+
+// setInt sets the integer value and its float64 equivalent.
+func (s *Signal) setInt(val int64) {
+	s.ValueInt = val
+	s.ValueNumber = float64(val)
+}
+
+// setUint sets the integer value if it fits in ValueInt and its float64 equivalent.
+func (s *Signal) setUint(val uint64) {
+	if val <= math.MaxInt64 {
+		s.ValueInt = int64(val)
+	}
+	s.ValueNumber = float64(val)
+}
+
 // SignalToSlice converts a Signal to an array of any for Clickhouse insertion.
 // The order of the elements in the array is guaranteed to match the order of elements in the `SignalColNames`.
 func SignalToSlice(obj Signal) []any {
@@ -68,6 +186,9 @@ func SignalToSlice(obj Signal) []any {
 		obj.Source,
 		obj.ValueNumber,
 		obj.ValueString,
+		obj.ValueBool,
+		obj.ValueInt,
+		obj.ValueStringArray,
 	}
 }
 
@@ -80,5 +201,8 @@ func SignalColNames() []string {
 		SourceCol,
 		ValueNumberCol,
 		ValueStringCol,
+		ValueBoolCol,
+		ValueIntCol,
+		ValueStringArrayCol,
 	}
 }
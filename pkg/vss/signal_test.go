@@ -0,0 +1,82 @@
+package vss_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTypedValue(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		dataType string
+		val      any
+		expected vss.Signal
+	}{
+		{name: "float", dataType: "float", val: 1.5, expected: vss.Signal{ValueNumber: 1.5}},
+		{name: "string", dataType: "string", val: "ELECTRIC", expected: vss.Signal{ValueString: "ELECTRIC"}},
+		{name: "boolean true", dataType: "boolean", val: 1.0, expected: vss.Signal{ValueNumber: 1, ValueBool: true}},
+		{name: "boolean false", dataType: "boolean", val: 0.0, expected: vss.Signal{}},
+		{name: "go bool", dataType: "boolean", val: true, expected: vss.Signal{ValueNumber: 1, ValueBool: true}},
+		{name: "integer", dataType: "uint16", val: 30.0, expected: vss.Signal{ValueNumber: 30, ValueInt: 30}},
+		{name: "fractional integer", dataType: "uint16", val: 30.5, expected: vss.Signal{ValueNumber: 30.5}},
+		{name: "negative unsigned integer", dataType: "uint8", val: -1.0, expected: vss.Signal{ValueNumber: -1}},
+		{name: "integer above range", dataType: "uint8", val: 256.0, expected: vss.Signal{ValueNumber: 256}},
+		{name: "integer at int64 limit", dataType: "int64", val: float64(math.MaxInt64), expected: vss.Signal{ValueNumber: math.MaxInt64}},
+		{name: "negative integer", dataType: "int8", val: -2.0, expected: vss.Signal{ValueNumber: -2, ValueInt: -2}},
+		{name: "go integer", dataType: "int8", val: int8(-2), expected: vss.Signal{ValueNumber: -2, ValueInt: -2}},
+		{name: "go uint", dataType: "uint32", val: uint(7), expected: vss.Signal{ValueNumber: 7, ValueInt: 7}},
+		{name: "go uint64 above int64", dataType: "uint64", val: uint64(math.MaxUint64), expected: vss.Signal{ValueNumber: math.MaxUint64}},
+		{
+			name:     "string array",
+			dataType: "string[]",
+			val:      []string{"GASOLINE", "DIESEL"},
+			expected: vss.Signal{ValueString: "[GASOLINE DIESEL]", ValueStringArray: []string{"GASOLINE", "DIESEL"}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			sig := vss.Signal{}
+			require.NoError(t, sig.SetTypedValue(tt.dataType, tt.val))
+			require.Equal(t, tt.expected, sig)
+		})
+	}
+
+	sig := vss.Signal{}
+	require.Error(t, sig.SetTypedValue("string[]", "GASOLINE"), "a plain string is not a string array")
+	require.Equal(t, vss.Signal{}, sig)
+}
+
+func TestSignalJSONOmitsEmptyTypedValues(t *testing.T) {
+	t.Parallel()
+	data, err := json.Marshal(vss.Signal{Name: vss.FieldSpeed, ValueNumber: 10})
+	require.NoError(t, err)
+	for _, field := range []string{"valueBool", "valueInt", "valueStringArray"} {
+		require.NotContains(t, string(data), field)
+	}
+}
+
+func TestSignalToSlice(t *testing.T) {
+	t.Parallel()
+	sig := vss.Signal{
+		Name:             vss.FieldPowertrainFuelSystemSupportedFuelTypes,
+		ValueStringArray: []string{"GASOLINE"},
+	}
+	values := vss.SignalToSlice(sig)
+	cols := vss.SignalColNames()
+	require.Len(t, values, len(cols))
+	for i, col := range cols {
+		switch col {
+		case vss.NameCol:
+			require.Equal(t, sig.Name, values[i])
+		case vss.ValueStringArrayCol:
+			require.Equal(t, sig.ValueStringArray, values[i])
+		}
+	}
+}
@@ -24,12 +24,20 @@ const (
 	FieldCurrentLocationLatitude = "currentLocationLatitude"
 	// FieldCurrentLocationLongitude Current longitude of vehicle in WGS 84 geodetic coordinates, as measured at the position of GNSS receiver antenna.
 	FieldCurrentLocationLongitude = "currentLocationLongitude"
+	// FieldDIMOAftermarketGSMSignalLevel Signal level of the aftermarket device's GSM/UMTS connection. 0 = no signal. 31 = strongest signal.
+	FieldDIMOAftermarketGSMSignalLevel = "dimoAftermarketGSMSignalLevel"
 	// FieldDIMOAftermarketHDOP Horizontal dilution of precision of GPS
 	FieldDIMOAftermarketHDOP = "dimoAftermarketHDOP"
+	// FieldDIMOAftermarketModemTemperature Temperature of the aftermarket device's cellular modem.
+	FieldDIMOAftermarketModemTemperature = "dimoAftermarketModemTemperature"
 	// FieldDIMOAftermarketNSAT Number of sync satellites for GPS
 	FieldDIMOAftermarketNSAT = "dimoAftermarketNSAT"
+	// FieldDIMOAftermarketPCBTemperature Temperature of the aftermarket device's circuit board.
+	FieldDIMOAftermarketPCBTemperature = "dimoAftermarketPCBTemperature"
 	// FieldDIMOAftermarketSSID Service Set Identifier for the wifi.
 	FieldDIMOAftermarketSSID = "dimoAftermarketSSID"
+	// FieldDIMOAftermarketSupplyVoltage Voltage supplied to the aftermarket device.
+	FieldDIMOAftermarketSupplyVoltage = "dimoAftermarketSupplyVoltage"
 	// FieldDIMOAftermarketWPAState Indicate the current WPA state for the device's wifi
 	FieldDIMOAftermarketWPAState = "dimoAftermarketWPAState"
 	// FieldExteriorAirTemperature Air temperature outside the vehicle.
@@ -123,3 +131,349 @@ const (
 	// FieldSpeed Vehicle speed.
 	FieldSpeed = "speed"
 )
+
+// Vehicle is a typed snapshot of the latest value of each signal of a vehicle.
+// Fields of signals without a value are nil.
+type Vehicle struct {
+	// TokenID is the token ID of the vehicle.
+	TokenID uint32 `json:"tokenId"`
+	// AngularVelocityYaw Vehicle rotation rate along Z (vertical).
+	AngularVelocityYaw *Value[float64] `json:"angularVelocityYaw,omitempty"`
+	// ChassisAxleRow1WheelLeftSpeed Rotational speed of a vehicle's wheel.
+	ChassisAxleRow1WheelLeftSpeed *Value[float64] `json:"chassisAxleRow1WheelLeftSpeed,omitempty"`
+	// ChassisAxleRow1WheelLeftTirePressure Tire pressure in kilo-Pascal.
+	ChassisAxleRow1WheelLeftTirePressure *Value[int64] `json:"chassisAxleRow1WheelLeftTirePressure,omitempty"`
+	// ChassisAxleRow1WheelRightSpeed Rotational speed of a vehicle's wheel.
+	ChassisAxleRow1WheelRightSpeed *Value[float64] `json:"chassisAxleRow1WheelRightSpeed,omitempty"`
+	// ChassisAxleRow1WheelRightTirePressure Tire pressure in kilo-Pascal.
+	ChassisAxleRow1WheelRightTirePressure *Value[int64] `json:"chassisAxleRow1WheelRightTirePressure,omitempty"`
+	// ChassisAxleRow2WheelLeftTirePressure Tire pressure in kilo-Pascal.
+	ChassisAxleRow2WheelLeftTirePressure *Value[int64] `json:"chassisAxleRow2WheelLeftTirePressure,omitempty"`
+	// ChassisAxleRow2WheelRightTirePressure Tire pressure in kilo-Pascal.
+	ChassisAxleRow2WheelRightTirePressure *Value[int64] `json:"chassisAxleRow2WheelRightTirePressure,omitempty"`
+	// CurrentLocationAltitude Current altitude relative to WGS 84 reference ellipsoid, as measured at the position of GNSS receiver antenna.
+	CurrentLocationAltitude *Value[float64] `json:"currentLocationAltitude,omitempty"`
+	// CurrentLocationIsRedacted Indicates if the latitude and longitude signals at the current timestamp have been redacted using a privacy zone.
+	CurrentLocationIsRedacted *Value[bool] `json:"currentLocationIsRedacted,omitempty"`
+	// CurrentLocationLatitude Current latitude of vehicle in WGS 84 geodetic coordinates, as measured at the position of GNSS receiver antenna.
+	CurrentLocationLatitude *Value[float64] `json:"currentLocationLatitude,omitempty"`
+	// CurrentLocationLongitude Current longitude of vehicle in WGS 84 geodetic coordinates, as measured at the position of GNSS receiver antenna.
+	CurrentLocationLongitude *Value[float64] `json:"currentLocationLongitude,omitempty"`
+	// DIMOAftermarketGSMSignalLevel Signal level of the aftermarket device's GSM/UMTS connection. 0 = no signal. 31 = strongest signal.
+	DIMOAftermarketGSMSignalLevel *Value[int64] `json:"dimoAftermarketGSMSignalLevel,omitempty"`
+	// DIMOAftermarketHDOP Horizontal dilution of precision of GPS
+	DIMOAftermarketHDOP *Value[float64] `json:"dimoAftermarketHDOP,omitempty"`
+	// DIMOAftermarketModemTemperature Temperature of the aftermarket device's cellular modem.
+	DIMOAftermarketModemTemperature *Value[float64] `json:"dimoAftermarketModemTemperature,omitempty"`
+	// DIMOAftermarketNSAT Number of sync satellites for GPS
+	DIMOAftermarketNSAT *Value[float64] `json:"dimoAftermarketNSAT,omitempty"`
+	// DIMOAftermarketPCBTemperature Temperature of the aftermarket device's circuit board.
+	DIMOAftermarketPCBTemperature *Value[float64] `json:"dimoAftermarketPCBTemperature,omitempty"`
+	// DIMOAftermarketSSID Service Set Identifier for the wifi.
+	DIMOAftermarketSSID *Value[string] `json:"dimoAftermarketSSID,omitempty"`
+	// DIMOAftermarketSupplyVoltage Voltage supplied to the aftermarket device.
+	DIMOAftermarketSupplyVoltage *Value[float64] `json:"dimoAftermarketSupplyVoltage,omitempty"`
+	// DIMOAftermarketWPAState Indicate the current WPA state for the device's wifi
+	DIMOAftermarketWPAState *Value[string] `json:"dimoAftermarketWPAState,omitempty"`
+	// ExteriorAirTemperature Air temperature outside the vehicle.
+	ExteriorAirTemperature *Value[float64] `json:"exteriorAirTemperature,omitempty"`
+	// LowVoltageBatteryCurrentVoltage Current Voltage of the low voltage battery.
+	LowVoltageBatteryCurrentVoltage *Value[float64] `json:"lowVoltageBatteryCurrentVoltage,omitempty"`
+	// OBDBarometricPressure PID 33 - Barometric pressure
+	OBDBarometricPressure *Value[float64] `json:"obdBarometricPressure,omitempty"`
+	// OBDCommandedEGR PID 2C - Commanded exhaust gas recirculation (EGR)
+	OBDCommandedEGR *Value[float64] `json:"obdCommandedEGR,omitempty"`
+	// OBDCommandedEVAP PID 2E - Commanded evaporative purge (EVAP) valve
+	OBDCommandedEVAP *Value[float64] `json:"obdCommandedEVAP,omitempty"`
+	// OBDDistanceSinceDTCClear PID 31 - Distance traveled since codes cleared
+	OBDDistanceSinceDTCClear *Value[float64] `json:"obdDistanceSinceDTCClear,omitempty"`
+	// OBDDistanceWithMIL PID 21 - Distance traveled with MIL on
+	OBDDistanceWithMIL *Value[float64] `json:"obdDistanceWithMIL,omitempty"`
+	// OBDEngineLoad PID 04 - Engine load in percent - 0 = no load, 100 = full load
+	OBDEngineLoad *Value[float64] `json:"obdEngineLoad,omitempty"`
+	// OBDFuelPressure PID 0A - Fuel pressure
+	OBDFuelPressure *Value[float64] `json:"obdFuelPressure,omitempty"`
+	// OBDIntakeTemp PID 0F - Intake temperature
+	OBDIntakeTemp *Value[float64] `json:"obdIntakeTemp,omitempty"`
+	// OBDLongTermFuelTrim1 PID 07 - Long Term (learned) Fuel Trim - Bank 1 - negative percent leaner, positive percent richer
+	OBDLongTermFuelTrim1 *Value[float64] `json:"obdLongTermFuelTrim1,omitempty"`
+	// OBDMAP PID 0B - Intake manifold pressure
+	OBDMAP *Value[float64] `json:"obdMAP,omitempty"`
+	// OBDO2WRSensor1Voltage PID 2x (byte CD) - Voltage for wide range/band oxygen sensor
+	OBDO2WRSensor1Voltage *Value[float64] `json:"obdO2WRSensor1Voltage,omitempty"`
+	// OBDO2WRSensor2Voltage PID 2x (byte CD) - Voltage for wide range/band oxygen sensor
+	OBDO2WRSensor2Voltage *Value[float64] `json:"obdO2WRSensor2Voltage,omitempty"`
+	// OBDRunTime PID 1F - Engine run time
+	OBDRunTime *Value[float64] `json:"obdRunTime,omitempty"`
+	// OBDShortTermFuelTrim1 PID 06 - Short Term (immediate) Fuel Trim - Bank 1 - negative percent leaner, positive percent richer
+	OBDShortTermFuelTrim1 *Value[float64] `json:"obdShortTermFuelTrim1,omitempty"`
+	// OBDWarmupsSinceDTCClear PID 30 - Number of warm-ups since codes cleared
+	OBDWarmupsSinceDTCClear *Value[int64] `json:"obdWarmupsSinceDTCClear,omitempty"`
+	// PowertrainCombustionEngineDieselExhaustFluidCapacity Capacity in liters of the Diesel Exhaust Fluid Tank.
+	PowertrainCombustionEngineDieselExhaustFluidCapacity *Value[float64] `json:"powertrainCombustionEngineDieselExhaustFluidCapacity,omitempty"`
+	// PowertrainCombustionEngineDieselExhaustFluidLevel Level of the Diesel Exhaust Fluid tank as percent of capacity. 0 = empty. 100 = full.
+	PowertrainCombustionEngineDieselExhaustFluidLevel *Value[int64] `json:"powertrainCombustionEngineDieselExhaustFluidLevel,omitempty"`
+	// PowertrainCombustionEngineECT Engine coolant temperature.
+	PowertrainCombustionEngineECT *Value[int64] `json:"powertrainCombustionEngineECT,omitempty"`
+	// PowertrainCombustionEngineEngineOilLevel Engine oil level.
+	PowertrainCombustionEngineEngineOilLevel *Value[string] `json:"powertrainCombustionEngineEngineOilLevel,omitempty"`
+	// PowertrainCombustionEngineEngineOilRelativeLevel Engine oil level as a percentage.
+	PowertrainCombustionEngineEngineOilRelativeLevel *Value[float64] `json:"powertrainCombustionEngineEngineOilRelativeLevel,omitempty"`
+	// PowertrainCombustionEngineMAF Grams of air drawn into engine per second.
+	PowertrainCombustionEngineMAF *Value[int64] `json:"powertrainCombustionEngineMAF,omitempty"`
+	// PowertrainCombustionEngineSpeed Engine speed measured as rotations per minute.
+	PowertrainCombustionEngineSpeed *Value[int64] `json:"powertrainCombustionEngineSpeed,omitempty"`
+	// PowertrainCombustionEngineTPS Current throttle position.
+	PowertrainCombustionEngineTPS *Value[int64] `json:"powertrainCombustionEngineTPS,omitempty"`
+	// PowertrainCombustionEngineTorque Current engine torque. Shall be reported as 0 during engine breaking.
+	PowertrainCombustionEngineTorque *Value[int64] `json:"powertrainCombustionEngineTorque,omitempty"`
+	// PowertrainFuelSystemAbsoluteLevel Current available fuel in the fuel tank expressed in liters.
+	PowertrainFuelSystemAbsoluteLevel *Value[float64] `json:"powertrainFuelSystemAbsoluteLevel,omitempty"`
+	// PowertrainFuelSystemRelativeLevel Level in fuel tank as percent of capacity. 0 = empty. 100 = full.
+	PowertrainFuelSystemRelativeLevel *Value[int64] `json:"powertrainFuelSystemRelativeLevel,omitempty"`
+	// PowertrainFuelSystemSupportedFuelTypes High level information of fuel types supported
+	PowertrainFuelSystemSupportedFuelTypes *Value[[]string] `json:"powertrainFuelSystemSupportedFuelTypes,omitempty"`
+	// PowertrainRange Remaining range in meters using all energy sources available in the vehicle.
+	PowertrainRange *Value[int64] `json:"powertrainRange,omitempty"`
+	// PowertrainTractionBatteryChargingAddedEnergy Amount of charge added to the high voltage battery during the current charging session, expressed in kilowatt-hours.
+	PowertrainTractionBatteryChargingAddedEnergy *Value[float64] `json:"powertrainTractionBatteryChargingAddedEnergy,omitempty"`
+	// PowertrainTractionBatteryChargingChargeLimit Target charge limit (state of charge) for battery.
+	PowertrainTractionBatteryChargingChargeLimit *Value[int64] `json:"powertrainTractionBatteryChargingChargeLimit,omitempty"`
+	// PowertrainTractionBatteryChargingIsCharging True if charging is ongoing. Charging is considered to be ongoing if energy is flowing from charger to vehicle.
+	PowertrainTractionBatteryChargingIsCharging *Value[bool] `json:"powertrainTractionBatteryChargingIsCharging,omitempty"`
+	// PowertrainTractionBatteryCurrentPower Current electrical energy flowing in/out of battery. Positive = Energy flowing in to battery, e.g. during charging. Negative = Energy flowing out of battery, e.g. during driving.
+	PowertrainTractionBatteryCurrentPower *Value[float64] `json:"powertrainTractionBatteryCurrentPower,omitempty"`
+	// PowertrainTractionBatteryCurrentVoltage Current Voltage of the battery.
+	PowertrainTractionBatteryCurrentVoltage *Value[float64] `json:"powertrainTractionBatteryCurrentVoltage,omitempty"`
+	// PowertrainTractionBatteryGrossCapacity Gross capacity of the battery.
+	PowertrainTractionBatteryGrossCapacity *Value[int64] `json:"powertrainTractionBatteryGrossCapacity,omitempty"`
+	// PowertrainTractionBatteryRange Remaining range in meters using only battery.
+	PowertrainTractionBatteryRange *Value[int64] `json:"powertrainTractionBatteryRange,omitempty"`
+	// PowertrainTractionBatteryStateOfChargeCurrent Physical state of charge of the high voltage battery, relative to net capacity. This is not necessarily the state of charge being displayed to the customer.
+	PowertrainTractionBatteryStateOfChargeCurrent *Value[float64] `json:"powertrainTractionBatteryStateOfChargeCurrent,omitempty"`
+	// PowertrainTractionBatteryTemperatureAverage Current average temperature of the battery cells.
+	PowertrainTractionBatteryTemperatureAverage *Value[float64] `json:"powertrainTractionBatteryTemperatureAverage,omitempty"`
+	// PowertrainTransmissionCurrentGear The current gear. 0=Neutral, 1/2/..=Forward, -1/-2/..=Reverse.
+	PowertrainTransmissionCurrentGear *Value[int64] `json:"powertrainTransmissionCurrentGear,omitempty"`
+	// PowertrainTransmissionTemperature The current gearbox temperature.
+	PowertrainTransmissionTemperature *Value[int64] `json:"powertrainTransmissionTemperature,omitempty"`
+	// PowertrainTransmissionTravelledDistance Odometer reading, total distance travelled during the lifetime of the transmission.
+	PowertrainTransmissionTravelledDistance *Value[float64] `json:"powertrainTransmissionTravelledDistance,omitempty"`
+	// PowertrainType Defines the powertrain type of the vehicle.
+	PowertrainType *Value[string] `json:"powertrainType,omitempty"`
+	// ServiceDistanceToService Remaining distance to service (of any kind). Negative values indicate service overdue.
+	ServiceDistanceToService *Value[float64] `json:"serviceDistanceToService,omitempty"`
+	// Speed Vehicle speed.
+	Speed *Value[float64] `json:"speed,omitempty"`
+}
+
+// SetSignal sets the field of the signal unless the field already holds a newer value.
+// It returns false if the signal name is not a field of Vehicle.
+func (v *Vehicle) SetSignal(sig Signal) bool {
+	switch sig.Name {
+	case FieldAngularVelocityYaw:
+		setValue(&v.AngularVelocityYaw, sig, sig.ValueNumber)
+	case FieldChassisAxleRow1WheelLeftSpeed:
+		setValue(&v.ChassisAxleRow1WheelLeftSpeed, sig, sig.ValueNumber)
+	case FieldChassisAxleRow1WheelLeftTirePressure:
+		setInt(&v.ChassisAxleRow1WheelLeftTirePressure, sig, "uint16")
+	case FieldChassisAxleRow1WheelRightSpeed:
+		setValue(&v.ChassisAxleRow1WheelRightSpeed, sig, sig.ValueNumber)
+	case FieldChassisAxleRow1WheelRightTirePressure:
+		setInt(&v.ChassisAxleRow1WheelRightTirePressure, sig, "uint16")
+	case FieldChassisAxleRow2WheelLeftTirePressure:
+		setInt(&v.ChassisAxleRow2WheelLeftTirePressure, sig, "uint16")
+	case FieldChassisAxleRow2WheelRightTirePressure:
+		setInt(&v.ChassisAxleRow2WheelRightTirePressure, sig, "uint16")
+	case FieldCurrentLocationAltitude:
+		setValue(&v.CurrentLocationAltitude, sig, sig.ValueNumber)
+	case FieldCurrentLocationIsRedacted:
+		setValue(&v.CurrentLocationIsRedacted, sig, sig.boolValue())
+	case FieldCurrentLocationLatitude:
+		setValue(&v.CurrentLocationLatitude, sig, sig.ValueNumber)
+	case FieldCurrentLocationLongitude:
+		setValue(&v.CurrentLocationLongitude, sig, sig.ValueNumber)
+	case FieldDIMOAftermarketGSMSignalLevel:
+		setInt(&v.DIMOAftermarketGSMSignalLevel, sig, "uint8")
+	case FieldDIMOAftermarketHDOP:
+		setValue(&v.DIMOAftermarketHDOP, sig, sig.ValueNumber)
+	case FieldDIMOAftermarketModemTemperature:
+		setValue(&v.DIMOAftermarketModemTemperature, sig, sig.ValueNumber)
+	case FieldDIMOAftermarketNSAT:
+		setValue(&v.DIMOAftermarketNSAT, sig, sig.ValueNumber)
+	case FieldDIMOAftermarketPCBTemperature:
+		setValue(&v.DIMOAftermarketPCBTemperature, sig, sig.ValueNumber)
+	case FieldDIMOAftermarketSSID:
+		setValue(&v.DIMOAftermarketSSID, sig, sig.ValueString)
+	case FieldDIMOAftermarketSupplyVoltage:
+		setValue(&v.DIMOAftermarketSupplyVoltage, sig, sig.ValueNumber)
+	case FieldDIMOAftermarketWPAState:
+		setValue(&v.DIMOAftermarketWPAState, sig, sig.ValueString)
+	case FieldExteriorAirTemperature:
+		setValue(&v.ExteriorAirTemperature, sig, sig.ValueNumber)
+	case FieldLowVoltageBatteryCurrentVoltage:
+		setValue(&v.LowVoltageBatteryCurrentVoltage, sig, sig.ValueNumber)
+	case FieldOBDBarometricPressure:
+		setValue(&v.OBDBarometricPressure, sig, sig.ValueNumber)
+	case FieldOBDCommandedEGR:
+		setValue(&v.OBDCommandedEGR, sig, sig.ValueNumber)
+	case FieldOBDCommandedEVAP:
+		setValue(&v.OBDCommandedEVAP, sig, sig.ValueNumber)
+	case FieldOBDDistanceSinceDTCClear:
+		setValue(&v.OBDDistanceSinceDTCClear, sig, sig.ValueNumber)
+	case FieldOBDDistanceWithMIL:
+		setValue(&v.OBDDistanceWithMIL, sig, sig.ValueNumber)
+	case FieldOBDEngineLoad:
+		setValue(&v.OBDEngineLoad, sig, sig.ValueNumber)
+	case FieldOBDFuelPressure:
+		setValue(&v.OBDFuelPressure, sig, sig.ValueNumber)
+	case FieldOBDIntakeTemp:
+		setValue(&v.OBDIntakeTemp, sig, sig.ValueNumber)
+	case FieldOBDLongTermFuelTrim1:
+		setValue(&v.OBDLongTermFuelTrim1, sig, sig.ValueNumber)
+	case FieldOBDMAP:
+		setValue(&v.OBDMAP, sig, sig.ValueNumber)
+	case FieldOBDO2WRSensor1Voltage:
+		setValue(&v.OBDO2WRSensor1Voltage, sig, sig.ValueNumber)
+	case FieldOBDO2WRSensor2Voltage:
+		setValue(&v.OBDO2WRSensor2Voltage, sig, sig.ValueNumber)
+	case FieldOBDRunTime:
+		setValue(&v.OBDRunTime, sig, sig.ValueNumber)
+	case FieldOBDShortTermFuelTrim1:
+		setValue(&v.OBDShortTermFuelTrim1, sig, sig.ValueNumber)
+	case FieldOBDWarmupsSinceDTCClear:
+		setInt(&v.OBDWarmupsSinceDTCClear, sig, "uint8")
+	case FieldPowertrainCombustionEngineDieselExhaustFluidCapacity:
+		setValue(&v.PowertrainCombustionEngineDieselExhaustFluidCapacity, sig, sig.ValueNumber)
+	case FieldPowertrainCombustionEngineDieselExhaustFluidLevel:
+		setInt(&v.PowertrainCombustionEngineDieselExhaustFluidLevel, sig, "uint8")
+	case FieldPowertrainCombustionEngineECT:
+		setInt(&v.PowertrainCombustionEngineECT, sig, "int16")
+	case FieldPowertrainCombustionEngineEngineOilLevel:
+		setValue(&v.PowertrainCombustionEngineEngineOilLevel, sig, sig.ValueString)
+	case FieldPowertrainCombustionEngineEngineOilRelativeLevel:
+		setValue(&v.PowertrainCombustionEngineEngineOilRelativeLevel, sig, sig.ValueNumber)
+	case FieldPowertrainCombustionEngineMAF:
+		setInt(&v.PowertrainCombustionEngineMAF, sig, "uint16")
+	case FieldPowertrainCombustionEngineSpeed:
+		setInt(&v.PowertrainCombustionEngineSpeed, sig, "uint16")
+	case FieldPowertrainCombustionEngineTPS:
+		setInt(&v.PowertrainCombustionEngineTPS, sig, "uint8")
+	case FieldPowertrainCombustionEngineTorque:
+		setInt(&v.PowertrainCombustionEngineTorque, sig, "uint16")
+	case FieldPowertrainFuelSystemAbsoluteLevel:
+		setValue(&v.PowertrainFuelSystemAbsoluteLevel, sig, sig.ValueNumber)
+	case FieldPowertrainFuelSystemRelativeLevel:
+		setInt(&v.PowertrainFuelSystemRelativeLevel, sig, "uint8")
+	case FieldPowertrainFuelSystemSupportedFuelTypes:
+		setValue(&v.PowertrainFuelSystemSupportedFuelTypes, sig, sig.ValueStringArray)
+	case FieldPowertrainRange:
+		setInt(&v.PowertrainRange, sig, "uint32")
+	case FieldPowertrainTractionBatteryChargingAddedEnergy:
+		setValue(&v.PowertrainTractionBatteryChargingAddedEnergy, sig, sig.ValueNumber)
+	case FieldPowertrainTractionBatteryChargingChargeLimit:
+		setInt(&v.PowertrainTractionBatteryChargingChargeLimit, sig, "uint8")
+	case FieldPowertrainTractionBatteryChargingIsCharging:
+		setValue(&v.PowertrainTractionBatteryChargingIsCharging, sig, sig.boolValue())
+	case FieldPowertrainTractionBatteryCurrentPower:
+		setValue(&v.PowertrainTractionBatteryCurrentPower, sig, sig.ValueNumber)
+	case FieldPowertrainTractionBatteryCurrentVoltage:
+		setValue(&v.PowertrainTractionBatteryCurrentVoltage, sig, sig.ValueNumber)
+	case FieldPowertrainTractionBatteryGrossCapacity:
+		setInt(&v.PowertrainTractionBatteryGrossCapacity, sig, "uint16")
+	case FieldPowertrainTractionBatteryRange:
+		setInt(&v.PowertrainTractionBatteryRange, sig, "uint32")
+	case FieldPowertrainTractionBatteryStateOfChargeCurrent:
+		setValue(&v.PowertrainTractionBatteryStateOfChargeCurrent, sig, sig.ValueNumber)
+	case FieldPowertrainTractionBatteryTemperatureAverage:
+		setValue(&v.PowertrainTractionBatteryTemperatureAverage, sig, sig.ValueNumber)
+	case FieldPowertrainTransmissionCurrentGear:
+		setInt(&v.PowertrainTransmissionCurrentGear, sig, "int8")
+	case FieldPowertrainTransmissionTemperature:
+		setInt(&v.PowertrainTransmissionTemperature, sig, "int16")
+	case FieldPowertrainTransmissionTravelledDistance:
+		setValue(&v.PowertrainTransmissionTravelledDistance, sig, sig.ValueNumber)
+	case FieldPowertrainType:
+		setValue(&v.PowertrainType, sig, sig.ValueString)
+	case FieldServiceDistanceToService:
+		setValue(&v.ServiceDistanceToService, sig, sig.ValueNumber)
+	case FieldSpeed:
+		setValue(&v.Speed, sig, sig.ValueNumber)
+	default:
+		return false
+	}
+	if v.TokenID == 0 {
+		v.TokenID = sig.TokenID
+	}
+	return true
+}
+
+// ToSignals returns a signal for each field of the vehicle that has a value.
+func (v *Vehicle) ToSignals() []Signal {
+	var signals []Signal
+	signals = appendValue(signals, v.TokenID, FieldAngularVelocityYaw, v.AngularVelocityYaw)
+	signals = appendValue(signals, v.TokenID, FieldChassisAxleRow1WheelLeftSpeed, v.ChassisAxleRow1WheelLeftSpeed)
+	signals = appendValue(signals, v.TokenID, FieldChassisAxleRow1WheelLeftTirePressure, v.ChassisAxleRow1WheelLeftTirePressure)
+	signals = appendValue(signals, v.TokenID, FieldChassisAxleRow1WheelRightSpeed, v.ChassisAxleRow1WheelRightSpeed)
+	signals = appendValue(signals, v.TokenID, FieldChassisAxleRow1WheelRightTirePressure, v.ChassisAxleRow1WheelRightTirePressure)
+	signals = appendValue(signals, v.TokenID, FieldChassisAxleRow2WheelLeftTirePressure, v.ChassisAxleRow2WheelLeftTirePressure)
+	signals = appendValue(signals, v.TokenID, FieldChassisAxleRow2WheelRightTirePressure, v.ChassisAxleRow2WheelRightTirePressure)
+	signals = appendValue(signals, v.TokenID, FieldCurrentLocationAltitude, v.CurrentLocationAltitude)
+	signals = appendValue(signals, v.TokenID, FieldCurrentLocationIsRedacted, v.CurrentLocationIsRedacted)
+	signals = appendValue(signals, v.TokenID, FieldCurrentLocationLatitude, v.CurrentLocationLatitude)
+	signals = appendValue(signals, v.TokenID, FieldCurrentLocationLongitude, v.CurrentLocationLongitude)
+	signals = appendValue(signals, v.TokenID, FieldDIMOAftermarketGSMSignalLevel, v.DIMOAftermarketGSMSignalLevel)
+	signals = appendValue(signals, v.TokenID, FieldDIMOAftermarketHDOP, v.DIMOAftermarketHDOP)
+	signals = appendValue(signals, v.TokenID, FieldDIMOAftermarketModemTemperature, v.DIMOAftermarketModemTemperature)
+	signals = appendValue(signals, v.TokenID, FieldDIMOAftermarketNSAT, v.DIMOAftermarketNSAT)
+	signals = appendValue(signals, v.TokenID, FieldDIMOAftermarketPCBTemperature, v.DIMOAftermarketPCBTemperature)
+	signals = appendValue(signals, v.TokenID, FieldDIMOAftermarketSSID, v.DIMOAftermarketSSID)
+	signals = appendValue(signals, v.TokenID, FieldDIMOAftermarketSupplyVoltage, v.DIMOAftermarketSupplyVoltage)
+	signals = appendValue(signals, v.TokenID, FieldDIMOAftermarketWPAState, v.DIMOAftermarketWPAState)
+	signals = appendValue(signals, v.TokenID, FieldExteriorAirTemperature, v.ExteriorAirTemperature)
+	signals = appendValue(signals, v.TokenID, FieldLowVoltageBatteryCurrentVoltage, v.LowVoltageBatteryCurrentVoltage)
+	signals = appendValue(signals, v.TokenID, FieldOBDBarometricPressure, v.OBDBarometricPressure)
+	signals = appendValue(signals, v.TokenID, FieldOBDCommandedEGR, v.OBDCommandedEGR)
+	signals = appendValue(signals, v.TokenID, FieldOBDCommandedEVAP, v.OBDCommandedEVAP)
+	signals = appendValue(signals, v.TokenID, FieldOBDDistanceSinceDTCClear, v.OBDDistanceSinceDTCClear)
+	signals = appendValue(signals, v.TokenID, FieldOBDDistanceWithMIL, v.OBDDistanceWithMIL)
+	signals = appendValue(signals, v.TokenID, FieldOBDEngineLoad, v.OBDEngineLoad)
+	signals = appendValue(signals, v.TokenID, FieldOBDFuelPressure, v.OBDFuelPressure)
+	signals = appendValue(signals, v.TokenID, FieldOBDIntakeTemp, v.OBDIntakeTemp)
+	signals = appendValue(signals, v.TokenID, FieldOBDLongTermFuelTrim1, v.OBDLongTermFuelTrim1)
+	signals = appendValue(signals, v.TokenID, FieldOBDMAP, v.OBDMAP)
+	signals = appendValue(signals, v.TokenID, FieldOBDO2WRSensor1Voltage, v.OBDO2WRSensor1Voltage)
+	signals = appendValue(signals, v.TokenID, FieldOBDO2WRSensor2Voltage, v.OBDO2WRSensor2Voltage)
+	signals = appendValue(signals, v.TokenID, FieldOBDRunTime, v.OBDRunTime)
+	signals = appendValue(signals, v.TokenID, FieldOBDShortTermFuelTrim1, v.OBDShortTermFuelTrim1)
+	signals = appendValue(signals, v.TokenID, FieldOBDWarmupsSinceDTCClear, v.OBDWarmupsSinceDTCClear)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineDieselExhaustFluidCapacity, v.PowertrainCombustionEngineDieselExhaustFluidCapacity)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineDieselExhaustFluidLevel, v.PowertrainCombustionEngineDieselExhaustFluidLevel)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineECT, v.PowertrainCombustionEngineECT)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineEngineOilLevel, v.PowertrainCombustionEngineEngineOilLevel)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineEngineOilRelativeLevel, v.PowertrainCombustionEngineEngineOilRelativeLevel)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineMAF, v.PowertrainCombustionEngineMAF)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineSpeed, v.PowertrainCombustionEngineSpeed)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineTPS, v.PowertrainCombustionEngineTPS)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainCombustionEngineTorque, v.PowertrainCombustionEngineTorque)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainFuelSystemAbsoluteLevel, v.PowertrainFuelSystemAbsoluteLevel)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainFuelSystemRelativeLevel, v.PowertrainFuelSystemRelativeLevel)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainFuelSystemSupportedFuelTypes, v.PowertrainFuelSystemSupportedFuelTypes)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainRange, v.PowertrainRange)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryChargingAddedEnergy, v.PowertrainTractionBatteryChargingAddedEnergy)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryChargingChargeLimit, v.PowertrainTractionBatteryChargingChargeLimit)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryChargingIsCharging, v.PowertrainTractionBatteryChargingIsCharging)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryCurrentPower, v.PowertrainTractionBatteryCurrentPower)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryCurrentVoltage, v.PowertrainTractionBatteryCurrentVoltage)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryGrossCapacity, v.PowertrainTractionBatteryGrossCapacity)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryRange, v.PowertrainTractionBatteryRange)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryStateOfChargeCurrent, v.PowertrainTractionBatteryStateOfChargeCurrent)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTractionBatteryTemperatureAverage, v.PowertrainTractionBatteryTemperatureAverage)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTransmissionCurrentGear, v.PowertrainTransmissionCurrentGear)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTransmissionTemperature, v.PowertrainTransmissionTemperature)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainTransmissionTravelledDistance, v.PowertrainTransmissionTravelledDistance)
+	signals = appendValue(signals, v.TokenID, FieldPowertrainType, v.PowertrainType)
+	signals = appendValue(signals, v.TokenID, FieldServiceDistanceToService, v.ServiceDistanceToService)
+	signals = appendValue(signals, v.TokenID, FieldSpeed, v.Speed)
+	return signals
+}
@@ -0,0 +1,67 @@
+package vss
+
+import (
+	"math"
+	"time"
+)
+
+// Value is the value of a signal in a Vehicle snapshot.
+type Value[T any] struct {
+	// Timestamp is when the value was collected.
+	Timestamp time.Time `json:"timestamp"`
+	// Source is the source of the value.
+	Source string `json:"source,omitempty"`
+	// Value is the value of the signal.
+	Value T `json:"value"`
+}
+
+// FromSignals creates a Vehicle from signals.
+// When a signal appears more than once the value with the latest timestamp is kept, and signals that are not fields of Vehicle are ignored.
+func FromSignals(signals []Signal) Vehicle {
+	var vehicle Vehicle
+	for i := range signals {
+		vehicle.SetSignal(signals[i])
+	}
+	return vehicle
+}
+
+// setValue sets the field to the value of the signal unless the field holds a value with a later timestamp.
+func setValue[T any](field **Value[T], sig Signal, val T) {
+	if *field != nil && (*field).Timestamp.After(sig.Timestamp) {
+		return
+	}
+	*field = &Value[T]{Timestamp: sig.Timestamp, Source: sig.Source, Value: val}
+}
+
+// appendValue appends the signal of a field if it has a value.
+func appendValue[T any](signals []Signal, tokenID uint32, name string, field *Value[T]) []Signal {
+	if field == nil {
+		return signals
+	}
+	sig := Signal{TokenID: tokenID, Name: name, Timestamp: field.Timestamp, Source: field.Source}
+	sig.SetValue(field.Value)
+	return append(signals, sig)
+}
+
+// boolValue returns the boolean value of the signal, falling back to ValueNumber for rows written before ValueBool existed.
+func (s *Signal) boolValue() bool {
+	return s.ValueBool || s.ValueNumber != 0
+}
+
+// setInt sets the field to the integer value of the signal unless the field holds a value with a later timestamp.
+// Fractional values are rounded to the nearest integer, and signals with a value outside the range of the data type are skipped.
+func setInt(field **Value[int64], sig Signal, dataType string) {
+	val, ok := sig.intValue(dataType)
+	if !ok {
+		return
+	}
+	setValue(field, sig, val)
+}
+
+// intValue returns the integer value of the signal, falling back to the rounded ValueNumber for rows written before ValueInt existed.
+func (s *Signal) intValue(dataType string) (int64, bool) {
+	if s.ValueInt != 0 && (s.ValueNumber == 0 || s.ValueNumber == float64(s.ValueInt)) {
+		return s.ValueInt, true
+	}
+	return IntValue(dataType, math.Round(s.ValueNumber))
+}
@@ -0,0 +1,69 @@
+package vss_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromSignals(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	signals := []vss.Signal{
+		{TokenID: 7, Timestamp: ts.Add(time.Minute), Name: vss.FieldSpeed, ValueNumber: 20, Source: "0xB"},
+		{TokenID: 7, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 10, Source: "0xA"},
+		{TokenID: 7, Timestamp: ts, Name: vss.FieldPowertrainType, ValueString: "ELECTRIC"},
+		{TokenID: 7, Timestamp: ts, Name: vss.FieldCurrentLocationIsRedacted, ValueNumber: 1},
+		{TokenID: 7, Timestamp: ts, Name: vss.FieldOBDWarmupsSinceDTCClear, ValueNumber: 3},
+		{TokenID: 7, Timestamp: ts, Name: vss.FieldPowertrainFuelSystemSupportedFuelTypes, ValueStringArray: []string{"GASOLINE"}},
+		{TokenID: 7, Timestamp: ts, Name: "notASignal", ValueNumber: 1},
+	}
+	vehicle := vss.FromSignals(signals)
+	require.Equal(t, uint32(7), vehicle.TokenID)
+	require.Equal(t, &vss.Value[float64]{Timestamp: ts.Add(time.Minute), Source: "0xB", Value: 20}, vehicle.Speed)
+	require.Equal(t, "ELECTRIC", vehicle.PowertrainType.Value)
+	require.True(t, vehicle.CurrentLocationIsRedacted.Value)
+	require.Equal(t, int64(3), vehicle.OBDWarmupsSinceDTCClear.Value)
+	require.Equal(t, []string{"GASOLINE"}, vehicle.PowertrainFuelSystemSupportedFuelTypes.Value)
+	require.Nil(t, vehicle.PowertrainTractionBatteryStateOfChargeCurrent)
+}
+
+func TestToSignals(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	vehicle := vss.Vehicle{
+		TokenID:                 7,
+		Speed:                   &vss.Value[float64]{Timestamp: ts, Source: "0xA", Value: 20},
+		OBDWarmupsSinceDTCClear: &vss.Value[int64]{Timestamp: ts, Value: 3},
+		PowertrainType:          &vss.Value[string]{Timestamp: ts, Value: "ELECTRIC"},
+	}
+	expected := []vss.Signal{
+		{TokenID: 7, Timestamp: ts, Name: vss.FieldOBDWarmupsSinceDTCClear, ValueNumber: 3, ValueInt: 3},
+		{TokenID: 7, Timestamp: ts, Name: vss.FieldPowertrainType, ValueString: "ELECTRIC"},
+		{TokenID: 7, Timestamp: ts, Name: vss.FieldSpeed, ValueNumber: 20, Source: "0xA"},
+	}
+	signals := vehicle.ToSignals()
+	require.Equal(t, expected, signals)
+	require.Equal(t, vehicle, vss.FromSignals(signals))
+}
+
+func TestFromSignalsIntegerValues(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	pressure := vss.Signal{TokenID: 7, Timestamp: ts, Name: vss.FieldChassisAxleRow1WheelLeftTirePressure}
+	pressure.SetTypedValue("uint16", 30.0)
+	fractional := vss.Signal{TokenID: 7, Timestamp: ts.Add(time.Minute), Name: vss.FieldChassisAxleRow1WheelLeftTirePressure}
+	fractional.SetTypedValue("uint16", 287.5)
+	negative := vss.Signal{TokenID: 7, Timestamp: ts, Name: vss.FieldOBDWarmupsSinceDTCClear, ValueNumber: -1}
+
+	// fractional values are rounded and out of range values are skipped.
+	vehicle := vss.FromSignals([]vss.Signal{pressure, fractional, negative})
+	require.Equal(t, &vss.Value[int64]{Timestamp: ts.Add(time.Minute), Value: 288}, vehicle.ChassisAxleRow1WheelLeftTirePressure)
+	require.Nil(t, vehicle.OBDWarmupsSinceDTCClear)
+
+	rounded := vss.Signal{TokenID: 7, Timestamp: ts.Add(time.Minute), Name: vss.FieldChassisAxleRow1WheelLeftTirePressure}
+	rounded.SetValue(int64(288))
+	require.Equal(t, []vss.Signal{rounded}, vehicle.ToSignals())
+}